@@ -0,0 +1,4 @@
+data "sleuth_code_change_source" "api" {
+  project_slug = "platform"
+  slug         = "api"
+}
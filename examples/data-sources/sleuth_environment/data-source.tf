@@ -0,0 +1,9 @@
+data "sleuth_environment" "prod" {
+  project_slug = "platform"
+  slug         = "production"
+}
+
+data "sleuth_environment" "staging" {
+  project_slug = "platform"
+  name         = "Staging"
+}
@@ -0,0 +1,4 @@
+data "sleuth_error_impact_source" "sentry" {
+  project_slug = "platform"
+  slug         = "sentry-errors"
+}
@@ -0,0 +1,4 @@
+data "sleuth_incident_impact_source" "pagerduty" {
+  project_slug = "platform"
+  slug         = "pagerduty-tf-incident-impact"
+}
@@ -0,0 +1,14 @@
+data "sleuth_integration" "datadog_prod" {
+  provider_type = "DATADOG"
+  label         = "prod"
+}
+
+resource "sleuth_metric_impact_source" "app_memory" {
+  project_slug     = "example_tf_app"
+  environment_slug = "prod"
+  name             = "Application Memory"
+  provider_type    = "datadog"
+  integration_slug = data.sleuth_integration.datadog_prod.slug
+  query            = "avg:aws.ecs.memory_utilization{*}"
+  less_is_better   = true
+}
@@ -0,0 +1,7 @@
+data "sleuth_integrations" "datadog" {
+  provider_type = "DATADOG"
+}
+
+output "datadog_integration_slugs" {
+  value = data.sleuth_integrations.datadog.slugs
+}
@@ -0,0 +1,4 @@
+data "sleuth_metric_impact_source" "latency" {
+  project_slug = "platform"
+  slug         = "api-latency"
+}
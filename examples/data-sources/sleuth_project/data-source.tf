@@ -0,0 +1,3 @@
+data "sleuth_project" "platform" {
+  slug = "platform"
+}
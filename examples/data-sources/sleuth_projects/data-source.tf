@@ -0,0 +1,14 @@
+data "sleuth_projects" "tier_1" {
+  labels = ["tier-1"]
+}
+
+resource "sleuth_metric_impact_source" "app_memory" {
+  for_each = toset(data.sleuth_projects.tier_1.slugs)
+
+  project_slug     = each.value
+  environment_slug = "prod"
+  name             = "Application Memory"
+  provider_type    = "datadog"
+  query            = "avg:aws.ecs.memory_utilization{project:${each.value}}"
+  less_is_better   = true
+}
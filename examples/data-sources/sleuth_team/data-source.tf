@@ -0,0 +1,3 @@
+data "sleuth_team" "platform" {
+  slug = "platform-team"
+}
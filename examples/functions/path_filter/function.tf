@@ -0,0 +1,19 @@
+resource "sleuth_code_change_source" "payments_api" {
+  project_slug = "payments"
+  name         = "Payments API"
+  repository = {
+    github = {
+      owner = "sleuth-io"
+      name  = "monorepo"
+    }
+  }
+  environment_mappings = [
+    {
+      environment_slug = "production"
+      branch           = "main"
+    },
+  ]
+  deploy_tracking_type = "manual"
+  # {"excludes":["services/payments/docs/*"],"includes":["services/payments/*"]}
+  path_prefix = provider::sleuth::path_filter(["services/payments/*"], ["services/payments/docs/*"])
+}
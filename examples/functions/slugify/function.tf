@@ -0,0 +1,4 @@
+# The slug of the project is known at plan time, before the project is created
+output "project_slug" {
+  value = provider::sleuth::slugify("Payments API") # "payments-api"
+}
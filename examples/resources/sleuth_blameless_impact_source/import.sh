@@ -0,0 +1,5 @@
+# Blameless incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_blameless_impact_source.blameless my-project/blameless-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_blameless_impact_source.blameless "my-project/name:Blameless incidents"
@@ -0,0 +1,8 @@
+resource "sleuth_blameless_impact_source" "blameless" {
+  project_slug              = "project_slug"
+  name                      = "Blameless TF incident impact"
+  environment_name          = "environment_name"
+  remote_types              = ["type1", "type2"]
+  remote_severity_threshold = "SEV1"
+  integration_slug          = "optional_integration_slug"
+}
@@ -0,0 +1,3 @@
+# Build mappings can be imported using the project slug, the code change source slug, the environment slug and the
+# build name
+terraform import sleuth_build_mapping.payments_staging_deploy example_tf_app/monorepo/payments-staging/deploy-payments
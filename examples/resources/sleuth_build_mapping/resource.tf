@@ -0,0 +1,16 @@
+resource "sleuth_environment_mapping" "payments_staging" {
+  project_slug            = "example_tf_app"
+  code_change_source_slug = "monorepo"
+  environment_slug        = "payments-staging"
+  branch                  = "develop"
+}
+
+resource "sleuth_build_mapping" "payments_staging_deploy" {
+  project_slug            = "example_tf_app"
+  code_change_source_slug = "monorepo"
+  # referencing the environment mapping makes sure it exists first
+  environment_slug = sleuth_environment_mapping.payments_staging.environment_slug
+  build_name       = "deploy-payments"
+  provider_type    = "GITHUB"
+  project_key      = "example/monorepo"
+}
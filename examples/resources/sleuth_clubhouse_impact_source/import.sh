@@ -0,0 +1,5 @@
+# Clubhouse incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_clubhouse_impact_source.clubhouse my-project/clubhouse-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_clubhouse_impact_source.clubhouse "my-project/name:Clubhouse incidents"
@@ -0,0 +1,7 @@
+resource "sleuth_clubhouse_impact_source" "clubhouse" {
+  project_slug     = "project_slug"
+  name             = "Clubhouse TF incident impact"
+  environment_name = "environment_name"
+  remote_query     = "id:135"
+  integration_slug = "optional_integration_slug"
+}
@@ -0,0 +1,8 @@
+# Code change sources can be imported using the project slug and the code change source slug
+terraform import sleuth_code_change_source.repo my-project/my-repository
+
+# or, when the slug is not known, the project slug and the code change source name
+terraform import sleuth_code_change_source.repo "my-project/name:My repository"
+
+# Generating configuration with `terraform plan -generate-config-out` requires Terraform 1.9.3 or later for this
+# resource, because of the computed repository webhook
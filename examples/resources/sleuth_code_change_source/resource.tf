@@ -2,10 +2,10 @@ resource "sleuth_code_change_source" "sleuth-terraform-provider" {
   project_slug = "example_tf_app"
   name         = "terraform-provider-sleuth"
   repository = {
-    name     = "terraform-provider-sleuth"
-    owner    = "sleuth-io"
-    provider = "GITHUB"
-    url      = "https://github.com/sleuth-io/terraform-provider-sleuth"
+    github = {
+      owner = "sleuth-io"
+      name  = "terraform-provider-sleuth"
+    }
   }
   environment_mappings = [
     {
@@ -19,8 +19,8 @@ resource "sleuth_code_change_source" "sleuth-terraform-provider" {
   ]
   deploy_tracking_type = "manual"
   collect_impact       = true
-  path_prefix = jsonencode({
-    excludes = [""]
-    includes = [""]
-  })
+  path_filters = {
+    include = ["services/payments/*"]
+    exclude = ["services/payments/docs/*"]
+  }
 }
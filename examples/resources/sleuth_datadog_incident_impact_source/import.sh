@@ -0,0 +1,5 @@
+# DataDog incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_datadog_incident_impact_source.datadog my-project/datadog-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_datadog_incident_impact_source.datadog "my-project/name:DataDog incidents"
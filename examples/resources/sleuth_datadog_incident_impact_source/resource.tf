@@ -0,0 +1,8 @@
+resource "sleuth_datadog_incident_impact_source" "datadog" {
+  project_slug              = "project_slug"
+  name                      = "DataDog TF incident impact"
+  environment_name          = "environment_name"
+  query                     = "@query=123" # use @ if you are using facets in DataDog
+  remote_priority_threshold = "ALL"        # or P1 to P5
+  integration_slug          = "optional_integration_slug"
+}
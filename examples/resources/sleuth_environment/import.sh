@@ -0,0 +1,5 @@
+# Environments can be imported using the project slug and the environment slug
+terraform import sleuth_environment.staging my-project/staging
+
+# or, when the slug is not known, the project slug and the environment name
+terraform import sleuth_environment.staging "my-project/name:Staging"
@@ -0,0 +1,2 @@
+# Environment mappings can be imported using the project slug, the code change source slug and the environment slug
+terraform import sleuth_environment_mapping.payments_staging example_tf_app/monorepo/payments-staging
@@ -0,0 +1,21 @@
+# The code change source is owned by the platform team and leaves mappings it does not configure alone
+resource "sleuth_code_change_source" "monorepo" {
+  project_slug = "example_tf_app"
+  name         = "monorepo"
+  repository = {
+    github = {
+      owner = "example"
+      name  = "monorepo"
+    }
+  }
+  ignore_external_mappings = true
+  deploy_tracking_type     = "build"
+}
+
+# Each service team maps its own environments
+resource "sleuth_environment_mapping" "payments_staging" {
+  project_slug            = "example_tf_app"
+  code_change_source_slug = sleuth_code_change_source.monorepo.slug
+  environment_slug        = "payments-staging"
+  branch                  = "develop"
+}
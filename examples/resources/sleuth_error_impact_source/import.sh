@@ -0,0 +1,5 @@
+# Error impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_error_impact_source.sentry my-project/sentry-errors
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_error_impact_source.sentry "my-project/name:Sentry errors"
@@ -0,0 +1,5 @@
+# FireHydrant incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_firehydrant_impact_source.firehydrant my-project/firehydrant-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_firehydrant_impact_source.firehydrant "my-project/name:FireHydrant incidents"
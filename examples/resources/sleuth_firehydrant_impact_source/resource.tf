@@ -0,0 +1,7 @@
+resource "sleuth_firehydrant_impact_source" "firehydrant" {
+  project_slug        = "project_slug"
+  name                = "FireHydrant TF incident impact"
+  environment_name    = "environment_name"
+  remote_services     = "service_uuid"
+  remote_environments = "environment_uuid"
+}
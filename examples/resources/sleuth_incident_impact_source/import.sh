@@ -0,0 +1,5 @@
+# Incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_incident_impact_source.pagerduty my-project/pagerduty-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_incident_impact_source.pagerduty "my-project/name:PagerDuty incidents"
@@ -61,7 +61,7 @@ resource "sleuth_incident_impact_source" "opsgenie" {
   project_slug     = "project_slug"
   name             = "OpsGenie TF incident impact"
   environment_name = "environment_name"
-  provider_name    = "opsgeanie"
+  provider_name    = "opsgenie"
   opsgenie_input = {
     remote_alert_tags         = "tag1"
     remote_incidents_tags     = "tag1"
@@ -0,0 +1,3 @@
+# Integrations can be imported using their slug. Sleuth does not return credentials, so the first apply after the
+# import sends the configured ones again
+terraform import sleuth_integration_auth.datadog_prod datadog-prod
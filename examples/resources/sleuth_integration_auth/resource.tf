@@ -0,0 +1,29 @@
+variable "datadog_api_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "datadog_app_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "sleuth_integration_auth" "datadog_prod" {
+  provider_type = "DATADOG"
+  label         = "prod"
+  datadog_credentials = {
+    api_key = var.datadog_api_key
+    app_key = var.datadog_app_key
+    site    = "datadoghq.eu"
+  }
+}
+
+resource "sleuth_metric_impact_source" "app_memory" {
+  project_slug     = "example_tf_app"
+  environment_slug = "prod"
+  name             = "Application Memory"
+  provider_type    = "datadog"
+  integration_slug = sleuth_integration_auth.datadog_prod.slug
+  query            = "avg:aws.ecs.memory_utilization{*}"
+  less_is_better   = true
+}
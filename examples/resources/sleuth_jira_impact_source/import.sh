@@ -0,0 +1,5 @@
+# JIRA incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_jira_impact_source.jira my-project/jira-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_jira_impact_source.jira "my-project/name:JIRA incidents"
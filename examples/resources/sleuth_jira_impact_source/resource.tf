@@ -0,0 +1,7 @@
+resource "sleuth_jira_impact_source" "jira" {
+  project_slug     = "project_slug"
+  name             = "JIRA TF incident impact"
+  environment_name = "environment_name"
+  remote_jql       = "status IN (\"Incident\")"
+  integration_slug = "optional_integration_slug"
+}
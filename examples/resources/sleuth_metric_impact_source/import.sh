@@ -0,0 +1,5 @@
+# Metric impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_metric_impact_source.latency my-project/latency
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_metric_impact_source.latency "my-project/name:Latency"
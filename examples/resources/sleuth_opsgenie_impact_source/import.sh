@@ -0,0 +1,5 @@
+# OpsGenie incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_opsgenie_impact_source.opsgenie my-project/opsgenie-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_opsgenie_impact_source.opsgenie "my-project/name:OpsGenie incidents"
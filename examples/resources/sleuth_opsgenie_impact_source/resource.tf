@@ -0,0 +1,10 @@
+resource "sleuth_opsgenie_impact_source" "opsgenie" {
+  project_slug              = "project_slug"
+  name                      = "OpsGenie TF incident impact"
+  environment_name          = "environment_name"
+  remote_alert_tags         = "tag1"
+  remote_incident_tags      = "tag1"
+  remote_priority_threshold = "P1"
+  remote_service            = "test_service"
+  remote_use_alerts         = false
+}
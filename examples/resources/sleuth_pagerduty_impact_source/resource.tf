@@ -0,0 +1,14 @@
+resource "sleuth_pagerduty_impact_source" "pagerduty" {
+  project_slug     = "project_slug"
+  name             = "PagerDuty TF incident impact"
+  environment_name = "environment_name"
+  remote_services  = "" # empty string means all services
+  remote_urgency   = "ANY"
+}
+
+# An existing sleuth_incident_impact_source is moved to the resource of its provider, without being recreated, by
+# replacing its block with the resource above and adding a moved block (Terraform 1.8 or later)
+moved {
+  from = sleuth_incident_impact_source.pagerduty
+  to   = sleuth_pagerduty_impact_source.pagerduty
+}
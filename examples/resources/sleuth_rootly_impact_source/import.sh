@@ -0,0 +1,5 @@
+# Rootly incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_rootly_impact_source.rootly my-project/rootly-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_rootly_impact_source.rootly "my-project/name:Rootly incidents"
@@ -0,0 +1,5 @@
+# Statuspage incident impact sources can be imported using the project slug and the impact source slug
+terraform import sleuth_statuspage_impact_source.statuspage my-project/statuspage-incidents
+
+# or, when the slug is not known, the project slug and the impact source name
+terraform import sleuth_statuspage_impact_source.statuspage "my-project/name:Statuspage incidents"
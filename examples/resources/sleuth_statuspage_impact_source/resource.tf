@@ -0,0 +1,9 @@
+resource "sleuth_statuspage_impact_source" "statuspage" {
+  project_slug                 = "project_slug"
+  name                         = "Statuspage TF incident impact"
+  environment_name             = "environment_name"
+  remote_page                  = "remote_page"
+  remote_component             = "remote_component"
+  remote_impact                = "remote_impact"
+  ignore_maintenance_incidents = false
+}
@@ -0,0 +1,150 @@
+package gqlclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shurcooL/graphql"
+)
+
+const (
+	// DefaultMaxConcurrentRequests is how many requests a client sends to Sleuth at the same time
+	DefaultMaxConcurrentRequests = 5
+	// projectCacheTTL is how long a project query result is reused. It only has to outlive a single plan or
+	// refresh walk, mutations invalidate it anyway.
+	projectCacheTTL = 30 * time.Second
+)
+
+// requestLimiter caps the number of requests in flight
+type requestLimiter chan struct{}
+
+func newRequestLimiter(size int) requestLimiter {
+	return make(requestLimiter, size)
+}
+
+func (l requestLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l requestLimiter) release() {
+	if l == nil {
+		return
+	}
+	<-l
+}
+
+type projectCacheEntry struct {
+	done    chan struct{}
+	value   interface{}
+	err     error
+	expires time.Time
+}
+
+// projectCache coalesces identical project queries: concurrent callers share a single in-flight request and
+// its result is reused for projectCacheTTL. Failed requests are not cached.
+type projectCache struct {
+	mu      sync.Mutex
+	entries map[string]*projectCacheEntry
+	now     func() time.Time
+}
+
+func newProjectCache() *projectCache {
+	return &projectCache{entries: map[string]*projectCacheEntry{}, now: time.Now}
+}
+
+func (pc *projectCache) get(ctx context.Context, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if pc == nil {
+		return fetch()
+	}
+	pc.mu.Lock()
+	entry, found := pc.entries[key]
+	if found && !entry.expires.IsZero() && pc.now().After(entry.expires) {
+		found = false
+	}
+	if !found {
+		entry = &projectCacheEntry{done: make(chan struct{})}
+		pc.entries[key] = entry
+		pc.mu.Unlock()
+
+		entry.value, entry.err = fetch()
+
+		pc.mu.Lock()
+		if entry.err != nil {
+			if pc.entries[key] == entry {
+				delete(pc.entries, key)
+			}
+		} else {
+			entry.expires = pc.now().Add(projectCacheTTL)
+		}
+		pc.mu.Unlock()
+		close(entry.done)
+		return entry.value, entry.err
+	}
+	pc.mu.Unlock()
+
+	select {
+	case <-entry.done:
+		return entry.value, entry.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// invalidate drops all cached results. Requests in flight complete for their current callers but are not reused.
+func (pc *projectCache) invalidate() {
+	if pc == nil {
+		return
+	}
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.entries = map[string]*projectCacheEntry{}
+}
+
+// projectSections are the parts of a project that resources read, fetched with a single query
+type projectSections struct {
+	Environments  []Environment
+	ChangeSources []struct {
+		Type         graphql.String
+		ChangeSource CodeChangeSource `graphql:"... on CodeChangeSource"`
+	}
+	ImpactSources []struct {
+		Type     graphql.String
+		Error    ErrorImpactSource    `graphql:"... on ErrorImpactSource"`
+		Metric   MetricImpactSource   `graphql:"... on MetricImpactSource"`
+		Incident IncidentImpactSource `graphql:"... on IncidentImpactSource"`
+	}
+}
+
+// getProjectSections fetches the environments and sources of the project at most once per project slug while the
+// result is fresh, so resources of the same project share a single query. A missing project is reported as
+// ErrNotFound.
+func (c *Client) getProjectSections(ctx context.Context, projectSlug string) (*projectSections, error) {
+	value, err := c.projectCache.get(ctx, projectSlug, func() (interface{}, error) {
+		var query struct {
+			Project projectSections `graphql:"project(projectSlug: $projectSlug)"`
+		}
+		variables := map[string]interface{}{
+			"projectSlug": graphql.ID(projectSlug),
+		}
+		if err := c.doQuery(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Project, nil
+	})
+	if err != nil {
+		if isNotFoundError(err, "project") {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return value.(*projectSections), nil
+}
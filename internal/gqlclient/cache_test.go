@@ -0,0 +1,147 @@
+package gqlclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestProjectCacheCoalescesConcurrentFetches(t *testing.T) {
+	pc := newProjectCache()
+	release := make(chan struct{})
+	var fetches int32
+	fetch := func() (interface{}, error) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		return "project", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := pc.get(context.Background(), "payments", fetch)
+			if err != nil || value != "project" {
+				t.Errorf("unexpected result %v, %v", value, err)
+			}
+		}()
+	}
+	// let the goroutines pile up on the in-flight fetch
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if fetches != 1 {
+		t.Errorf("expected 1 fetch, got %d", fetches)
+	}
+}
+
+func TestProjectCacheExpiryAndInvalidation(t *testing.T) {
+	pc := newProjectCache()
+	now := time.Now()
+	pc.now = func() time.Time { return now }
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		return fetches, nil
+	}
+
+	_, _ = pc.get(context.Background(), "payments", fetch)
+	_, _ = pc.get(context.Background(), "payments", fetch)
+	if fetches != 1 {
+		t.Fatalf("expected a cached result, got %d fetches", fetches)
+	}
+
+	_, _ = pc.get(context.Background(), "search", fetch)
+	if fetches != 2 {
+		t.Fatalf("expected projects to be cached separately, got %d fetches", fetches)
+	}
+
+	pc.invalidate()
+	_, _ = pc.get(context.Background(), "payments", fetch)
+	if fetches != 3 {
+		t.Fatalf("expected a fetch after invalidation, got %d fetches", fetches)
+	}
+
+	now = now.Add(projectCacheTTL + time.Second)
+	_, _ = pc.get(context.Background(), "payments", fetch)
+	if fetches != 4 {
+		t.Fatalf("expected a fetch after expiry, got %d fetches", fetches)
+	}
+}
+
+func TestProjectCacheDoesNotCacheErrors(t *testing.T) {
+	pc := newProjectCache()
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		if fetches == 1 {
+			return nil, errors.New("bad gateway")
+		}
+		return "project", nil
+	}
+
+	if _, err := pc.get(context.Background(), "payments", fetch); err == nil {
+		t.Fatal("expected the first fetch to fail")
+	}
+	value, err := pc.get(context.Background(), "payments", fetch)
+	if err != nil || value != "project" {
+		t.Fatalf("expected the failed fetch to be retried, got %v, %v", value, err)
+	}
+}
+
+func TestProjectSectionsShareOneQuery(t *testing.T) {
+	var queries int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&queries, 1)
+		_, _ = w.Write([]byte(`{"data": {"project": {
+			"environments": [{"slug": "production", "name": "Production"}],
+			"changeSources": [{"type": "CODE", "slug": "api", "name": "API"}],
+			"impactSources": [
+				{"type": "ERROR", "slug": "sentry", "name": "Sentry", "provider": "SENTRY"},
+				{"type": "METRIC", "slug": "latency", "name": "Latency", "provider": "DATADOG"},
+				{"type": "INCIDENT", "slug": "pagerduty", "name": "PagerDuty", "provider": "PAGERDUTY"}
+			]
+		}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiKey := "key"
+	client, err := NewClient(&server.URL, &apiKey, "acme", "test", 0, RetryPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	projectSlug := "payments"
+
+	environments, err := client.getProjectEnvironments(ctx, projectSlug)
+	if err != nil || len(environments) != 1 {
+		t.Errorf("unexpected environments %+v, %v", environments, err)
+	}
+	codeChangeSources, err := client.getProjectCodeChangeSources(ctx, projectSlug)
+	if err != nil || len(codeChangeSources) != 1 {
+		t.Errorf("unexpected code change sources %+v, %v", codeChangeSources, err)
+	}
+	errorSources, err := client.getProjectErrorImpactSources(ctx, projectSlug)
+	if err != nil || len(errorSources) != 1 || errorSources[0].Slug != "sentry" {
+		t.Errorf("unexpected error impact sources %+v, %v", errorSources, err)
+	}
+	metricSources, err := client.getProjectMetricImpactSources(ctx, projectSlug)
+	if err != nil || len(metricSources) != 1 || metricSources[0].Slug != "latency" {
+		t.Errorf("unexpected metric impact sources %+v, %v", metricSources, err)
+	}
+	incidentSources, err := client.getProjectIncidentImpactSources(ctx, projectSlug)
+	if err != nil || len(incidentSources) != 1 || incidentSources[0].Slug != "pagerduty" {
+		t.Errorf("unexpected incident impact sources %+v, %v", incidentSources, err)
+	}
+
+	if queries != 1 {
+		t.Errorf("expected a single project query, got %d", queries)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/shurcooL/graphql"
@@ -16,6 +17,12 @@ type Client struct {
 	GQLClient  *graphql.Client
 	ApiKey     string
 	OrgSlug    string
+	// ValidateReferencesOnPlan makes resources check the slugs they reference against the API while planning
+	ValidateReferencesOnPlan bool
+
+	limiter      requestLimiter
+	projectCache *projectCache
+	sourceLocks  keyedMutex
 }
 
 type AuthenticatedTransport struct {
@@ -31,20 +38,33 @@ func (transport *AuthenticatedTransport) RoundTrip(req *http.Request) (*http.Res
 }
 
 // NewClient -
-func NewClient(baseurl, apiKey *string, ua string, timeout time.Duration) (*Client, error) {
-	httpClient := http.Client{Timeout: timeout,
-		Transport: &AuthenticatedTransport{http.DefaultTransport, *apiKey, ua}}
+func NewClient(baseurl, apiKey *string, orgSlug string, ua string, timeout time.Duration, retry RetryPolicy) (*Client, error) {
+	httpClient := http.Client{
+		Transport: &RetryTransport{
+			T:       &AuthenticatedTransport{http.DefaultTransport, *apiKey, ua},
+			Policy:  retry,
+			Timeout: timeout,
+		}}
 	c := Client{
 		GQLClient:  graphql.NewClient(*baseurl+"/graphql", &httpClient),
 		HTTPClient: &httpClient,
 		Baseurl:    *baseurl,
 		ApiKey:     *apiKey,
+		OrgSlug:    orgSlug,
+
+		limiter:      newRequestLimiter(DefaultMaxConcurrentRequests),
+		projectCache: newProjectCache(),
 	}
 
 	return &c, nil
 }
 
 func (c *Client) doQuery(ctx context.Context, query interface{}, variables map[string]interface{}) error {
+	if err := c.limiter.acquire(ctx); err != nil {
+		return err
+	}
+	defer c.limiter.release()
+
 	err := c.GQLClient.Query(ctx, query, variables)
 	if err != nil {
 		return err
@@ -53,9 +73,39 @@ func (c *Client) doQuery(ctx context.Context, query interface{}, variables map[s
 }
 
 func (c *Client) doMutate(ctx context.Context, query interface{}, variables map[string]interface{}) error {
-	err := c.GQLClient.Mutate(ctx, query, variables)
+	if err := c.limiter.acquire(ctx); err != nil {
+		return err
+	}
+	defer c.limiter.release()
+	// whatever the outcome, cached project reads may be stale now
+	defer c.projectCache.invalidate()
+
+	err := c.GQLClient.Mutate(withMutation(ctx), query, variables)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// keyedMutex serializes callers in this process using the same key. The zero value is ready to use.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock blocks until key is free and returns the function releasing it
+func (km *keyedMutex) lock(key string) func() {
+	km.mu.Lock()
+	if km.locks == nil {
+		km.locks = map[string]*sync.Mutex{}
+	}
+	l, ok := km.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		km.locks[key] = l
+	}
+	km.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
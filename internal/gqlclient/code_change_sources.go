@@ -2,18 +2,29 @@ package gqlclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/shurcooL/graphql"
 )
 
-// ErrNoCodeChangeSourceFound indicates that a code change source with the given
-// project slug & slug does not exist.
-var ErrNoCodeChangeSourceFound = errors.New("code change source not found")
+// getProjectCodeChangeSources - Returns all code change sources of the project, shared by concurrent callers
+func (c *Client) getProjectCodeChangeSources(ctx context.Context, projectSlug string) ([]CodeChangeSource, error) {
+	project, err := c.getProjectSections(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	var sources []CodeChangeSource
+	for _, src := range project.ChangeSources {
+		if src.Type == "CODE" {
+			sources = append(sources, src.ChangeSource)
+		}
+	}
+	return sources, nil
+}
 
-func (c *Client) GetCodeChangeSource(ctx context.Context, projectSlug *string, slug *string) (*CodeChangeSource, error) {
+// fetchProjectCodeChangeSources - Queries all code change sources of the project, bypassing the project cache
+func (c *Client) fetchProjectCodeChangeSources(ctx context.Context, projectSlug string) ([]CodeChangeSource, error) {
 	var query struct {
 		Project struct {
 			ChangeSources []struct {
@@ -23,7 +34,7 @@ func (c *Client) GetCodeChangeSource(ctx context.Context, projectSlug *string, s
 		} `graphql:"project(projectSlug: $projectSlug)"`
 	}
 	variables := map[string]interface{}{
-		"projectSlug": graphql.ID(*projectSlug),
+		"projectSlug": graphql.ID(projectSlug),
 	}
 
 	err := c.doQuery(ctx, &query, variables)
@@ -32,19 +43,49 @@ func (c *Client) GetCodeChangeSource(ctx context.Context, projectSlug *string, s
 		return nil, err
 	}
 
+	var sources []CodeChangeSource
 	for _, src := range query.Project.ChangeSources {
 		if src.Type == "CODE" {
-			if src.ChangeSource.Slug == *slug {
-				src.ChangeSource.Repository.Provider = strings.ToUpper(src.ChangeSource.Repository.Provider)
-				// TODO: this should not be done here but we want to be consistent for now
-				for idx, buildMapping := range src.ChangeSource.DeployTrackingBuildMappings {
-					src.ChangeSource.DeployTrackingBuildMappings[idx].Provider = strings.ToUpper(buildMapping.Provider)
-				}
-				return &src.ChangeSource, nil
-			}
+			sources = append(sources, src.ChangeSource)
+		}
+	}
+	return sources, nil
+}
+
+func (c *Client) GetCodeChangeSource(ctx context.Context, projectSlug *string, slug *string) (*CodeChangeSource, error) {
+	sources, err := c.getProjectCodeChangeSources(ctx, *projectSlug)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findCodeChangeSource(sources, *slug)
+}
+
+// findCodeChangeSource - Returns a copy of the source with the given slug
+func findCodeChangeSource(sources []CodeChangeSource, slug string) (*CodeChangeSource, error) {
+	for _, ccs := range sources {
+		if ccs.Slug == slug {
+			return &ccs, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// GetCodeChangeSourceByName - Returns the code change source with the given name
+func (c *Client) GetCodeChangeSourceByName(ctx context.Context, projectSlug *string, name *string) (*CodeChangeSource, error) {
+	sources, err := c.getProjectCodeChangeSources(ctx, *projectSlug)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ccs := range sources {
+		if ccs.Name == *name {
+			return c.GetCodeChangeSource(ctx, projectSlug, &ccs.Slug)
 		}
 	}
-	return nil, ErrNoCodeChangeSourceFound
+	return nil, ErrNotFound
 }
 
 func (c *Client) CreateCodeChangeSource(ctx context.Context, input CreateCodeChangeSourceMutationInput) (*CodeChangeSource, error) {
@@ -67,7 +108,7 @@ func (c *Client) CreateCodeChangeSource(ctx context.Context, input CreateCodeCha
 	}
 
 	if len(m.CreateCodeChangeSource.Errors) > 0 {
-		return nil, fmt.Errorf("errors creating change source: %+v", m.CreateCodeChangeSource.Errors)
+		return nil, newMutationError("creating change source", m.CreateCodeChangeSource.Errors)
 	}
 	return &m.CreateCodeChangeSource.ChangeSource, nil
 }
@@ -92,7 +133,221 @@ func (c *Client) UpdateCodeChangeSource(ctx context.Context, input UpdateCodeCha
 	}
 
 	if len(m.UpdateCodeChangeSource.Errors) > 0 {
-		return nil, fmt.Errorf("Errors updating code change source: %+v", m.UpdateCodeChangeSource.Errors)
+		return nil, newMutationError("updating code change source", m.UpdateCodeChangeSource.Errors)
 	}
 	return &m.UpdateCodeChangeSource.ChangeSource, nil
 }
+
+// maxMappingUpdateAttempts is how many times UpdateCodeChangeSourceMappings saves mappings that another writer undid
+const maxMappingUpdateAttempts = 3
+
+// UpdateCodeChangeSourceMappings - Lets update change the environment and build mappings of the code change source
+// and saves it. Updates replace all mappings, so the source is read right before the update, bypassing the project
+// cache, and updates of the same source through this client are serialized. Another writer, like another apply, can
+// still save the source between the read and the update and undo the change, so the source is read again after the
+// update and the update is retried if the mappings it added, changed or removed are not as saved.
+func (c *Client) UpdateCodeChangeSourceMappings(ctx context.Context, projectSlug, slug string, update func(ccs *MutableCodeChangeSource) error) (*CodeChangeSource, error) {
+	unlock := c.sourceLocks.lock(projectSlug + "/" + slug)
+	defer unlock()
+
+	ccs, err := c.fetchCodeChangeSource(ctx, projectSlug, slug)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		input := mutableCodeChangeSource(ccs)
+		if err := update(input); err != nil {
+			return nil, err
+		}
+		changes := getMappingChanges(mutableCodeChangeSource(ccs), input)
+
+		updated, err := c.UpdateCodeChangeSource(ctx, UpdateCodeChangeSourceMutationInput{
+			ProjectSlug:             projectSlug,
+			Slug:                    slug,
+			MutableCodeChangeSource: input,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if changes.empty() {
+			return findCodeChangeSource([]CodeChangeSource{*updated}, slug)
+		}
+
+		ccs, err = c.fetchCodeChangeSource(ctx, projectSlug, slug)
+		if err != nil {
+			return nil, err
+		}
+		if changes.savedIn(mutableCodeChangeSource(ccs)) {
+			return ccs, nil
+		}
+		if attempt == maxMappingUpdateAttempts {
+			return nil, fmt.Errorf("mappings of code change source %s were changed by another writer while saving them, "+
+				"gave up after %d attempts", slug, attempt)
+		}
+	}
+}
+
+// fetchCodeChangeSource - Returns the code change source of the project, bypassing the project cache
+func (c *Client) fetchCodeChangeSource(ctx context.Context, projectSlug, slug string) (*CodeChangeSource, error) {
+	sources, err := c.fetchProjectCodeChangeSources(ctx, projectSlug)
+	if err != nil {
+		if isNotFoundError(err, "project") {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return findCodeChangeSource(sources, slug)
+}
+
+// mappingChanges are the mappings an update adds or changes, by environment slug or build mapping key, and the keys of
+// the mappings it removes
+type mappingChanges struct {
+	environments        map[string]BranchMapping
+	removedEnvironments []string
+	builds              map[string]BuildMapping
+	removedBuilds       []string
+}
+
+// getMappingChanges returns the mappings changed from before to after
+func getMappingChanges(before, after *MutableCodeChangeSource) mappingChanges {
+	changes := mappingChanges{environments: map[string]BranchMapping{}, builds: map[string]BuildMapping{}}
+
+	environmentsBefore := environmentMappingsByKey(before.EnvironmentMappings)
+	for key, em := range environmentMappingsByKey(after.EnvironmentMappings) {
+		if prior, found := environmentsBefore[key]; !found || prior != em {
+			changes.environments[key] = em
+		}
+		delete(environmentsBefore, key)
+	}
+	for key := range environmentsBefore {
+		changes.removedEnvironments = append(changes.removedEnvironments, key)
+	}
+
+	buildsBefore := buildMappingsByKey(before.BuildMappings)
+	for key, bm := range buildMappingsByKey(after.BuildMappings) {
+		if prior, found := buildsBefore[key]; !found || !sameBuildMapping(bm, prior) {
+			changes.builds[key] = bm
+		}
+		delete(buildsBefore, key)
+	}
+	for key := range buildsBefore {
+		changes.removedBuilds = append(changes.removedBuilds, key)
+	}
+	return changes
+}
+
+func (changes mappingChanges) empty() bool {
+	return len(changes.environments) == 0 && len(changes.removedEnvironments) == 0 &&
+		len(changes.builds) == 0 && len(changes.removedBuilds) == 0
+}
+
+// savedIn reports whether the changed mappings are as saved in ccs, so no other writer undid them
+func (changes mappingChanges) savedIn(ccs *MutableCodeChangeSource) bool {
+	environments := environmentMappingsByKey(ccs.EnvironmentMappings)
+	for key, em := range changes.environments {
+		if environments[key] != em {
+			return false
+		}
+	}
+	for _, key := range changes.removedEnvironments {
+		if _, found := environments[key]; found {
+			return false
+		}
+	}
+
+	builds := buildMappingsByKey(ccs.BuildMappings)
+	for key, bm := range changes.builds {
+		saved, found := builds[key]
+		if !found || !sameBuildMapping(bm, saved) {
+			return false
+		}
+	}
+	for _, key := range changes.removedBuilds {
+		if _, found := builds[key]; found {
+			return false
+		}
+	}
+	return true
+}
+
+func environmentMappingsByKey(mappings []BranchMapping) map[string]BranchMapping {
+	byKey := make(map[string]BranchMapping, len(mappings))
+	for _, em := range mappings {
+		byKey[em.EnvironmentSlug] = em
+	}
+	return byKey
+}
+
+func buildMappingsByKey(mappings []BuildMapping) map[string]BuildMapping {
+	byKey := make(map[string]BuildMapping, len(mappings))
+	for _, bm := range mappings {
+		byKey[bm.EnvironmentSlug+"/"+bm.BuildName] = bm
+	}
+	return byKey
+}
+
+// sameBuildMapping reports whether saved is the build mapping expected was saved as. The build branch follows the
+// environment mapping and is not returned by the API, and values left empty are filled in by the API, like the key of
+// a build project given by name, so they are not compared.
+func sameBuildMapping(expected, saved BuildMapping) bool {
+	sameIfSet := func(expected, saved string) bool {
+		return expected == "" || expected == saved
+	}
+	return strings.EqualFold(expected.Provider, saved.Provider) &&
+		expected.JobName == saved.JobName &&
+		sameIfSet(expected.BuildProjectKey, saved.BuildProjectKey) &&
+		sameIfSet(expected.BuildProjectName, saved.BuildProjectName) &&
+		sameIfSet(expected.IntegrationSlug, saved.IntegrationSlug) &&
+		expected.MatchBranchToEnvironment == saved.MatchBranchToEnvironment &&
+		expected.IsCustom == saved.IsCustom
+}
+
+// mutableCodeChangeSource - Returns the mutation input that saves ccs as it is. The API returns providers in
+// lowercase but only accepts them in uppercase. Build projects are given by key, along with the name they were
+// configured with, if any, so it is kept.
+func mutableCodeChangeSource(ccs *CodeChangeSource) *MutableCodeChangeSource {
+	input := &MutableCodeChangeSource{
+		Name: ccs.Name,
+		Repository: MutableRepository{
+			RepositoryBase: ccs.Repository.RepositoryBase,
+		},
+		DeployTrackingType:  ccs.DeployTrackingType,
+		CollectImpact:       ccs.CollectImpact,
+		PathPrefix:          ccs.PathPrefix,
+		NotifyInSlack:       ccs.NotifyInSlack,
+		IncludeInDashboard:  ccs.IncludeInDashboard,
+		AutoTrackingDelay:   ccs.AutoTrackingDelay,
+		EnvironmentMappings: append([]BranchMapping(nil), ccs.EnvironmentMappings...),
+	}
+	input.Repository.Provider = strings.ToUpper(ccs.Repository.Provider)
+	if ccs.Repository.IntegrationAuth != nil {
+		input.Repository.IntegrationSlug = ccs.Repository.IntegrationAuth.Slug
+	}
+
+	for _, bm := range ccs.DeployTrackingBuildMappings {
+		input.BuildMappings = append(input.BuildMappings, BuildMapping{
+			EnvironmentSlug:          bm.Environment.Slug,
+			Provider:                 strings.ToUpper(bm.Provider),
+			BuildName:                bm.BuildName,
+			JobName:                  bm.JobName,
+			BuildProjectKey:          bm.BuildProjectKey,
+			BuildProjectName:         bm.BuildProjectName,
+			IntegrationSlug:          bm.IntegrationSlug,
+			MatchBranchToEnvironment: bm.MatchBranchToEnvironment,
+			IsCustom:                 bm.IsCustom,
+		})
+	}
+	input.SetBuildBranches()
+	return input
+}
+
+// SetBuildBranches - Sets the branch of each build mapping to the branch its environment is mapped to
+func (ccs *MutableCodeChangeSource) SetBuildBranches() {
+	branches := map[string]string{}
+	for _, em := range ccs.EnvironmentMappings {
+		branches[em.EnvironmentSlug] = em.Branch
+	}
+	for idx, bm := range ccs.BuildMappings {
+		ccs.BuildMappings[idx].BuildBranch = branches[bm.EnvironmentSlug]
+	}
+}
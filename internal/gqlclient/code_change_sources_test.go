@@ -0,0 +1,145 @@
+package gqlclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// lowercaseCodeChangeSource is a code change source with the providers in lowercase, as the API returns them
+const lowercaseCodeChangeSource = `{
+	"slug": "api",
+	"name": "API",
+	"repository": {"owner": "acme", "name": "api", "provider": "github", "url": "https://github.com/acme/api"},
+	"deployTrackingType": "build",
+	"environmentMappings": [{"environmentSlug": "prod", "branch": "main"}],
+	"deployTrackingBuildMappings": [
+		{"environment": {"slug": "prod"}, "provider": "circleci", "buildName": "deploy", "integrationSlug": "circleci"}
+	]
+}`
+
+// newCodeChangeSourceServer serves lowercaseCodeChangeSource and saves the environment mappings of each mutation of it,
+// unless undone reports that another writer saved the source again right after the mutation with the given number.
+// It returns the inputs of the mutations.
+func newCodeChangeSourceServer(t *testing.T, undone func(mutation int) bool) (*Client, *[]MutableCodeChangeSource) {
+	var source map[string]interface{}
+	if err := json.Unmarshal([]byte(lowercaseCodeChangeSource), &source); err != nil {
+		t.Fatal(err)
+	}
+	inputs := []MutableCodeChangeSource{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string
+			Variables struct {
+				Input MutableCodeChangeSource
+			}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if strings.HasPrefix(body.Query, "mutation") {
+			inputs = append(inputs, body.Variables.Input)
+			if !undone(len(inputs)) {
+				source["environmentMappings"] = body.Variables.Input.EnvironmentMappings
+			}
+			response, _ := json.Marshal(map[string]interface{}{"changeSource": source, "errors": []string{}})
+			_, _ = w.Write([]byte(`{"data": {"updateCodeChangeSource": ` + string(response) + `}}`))
+			return
+		}
+		changeSource := map[string]interface{}{"type": "CODE"}
+		for key, value := range source {
+			changeSource[key] = value
+		}
+		response, _ := json.Marshal([]interface{}{changeSource})
+		_, _ = w.Write([]byte(`{"data": {"project": {"changeSources": ` + string(response) + `}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiKey := "key"
+	client, err := NewClient(&server.URL, &apiKey, "acme", "test", 0, RetryPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &inputs
+}
+
+// addStagingMapping maps the staging environment to the develop branch
+func addStagingMapping(ccs *MutableCodeChangeSource) error {
+	ccs.EnvironmentMappings = append(ccs.EnvironmentMappings, BranchMapping{EnvironmentSlug: "staging", Branch: "develop"})
+	return nil
+}
+
+func TestUpdateCodeChangeSourceMappingsSendsUppercaseProviders(t *testing.T) {
+	client, inputs := newCodeChangeSourceServer(t, func(int) bool { return false })
+
+	_, err := client.UpdateCodeChangeSourceMappings(context.Background(), "payments", "api", addStagingMapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := (*inputs)[0]
+	if input.Repository.Provider != "GITHUB" {
+		t.Errorf("expected repository provider GITHUB, got %q", input.Repository.Provider)
+	}
+	if input.DeployTrackingType != "BUILD" {
+		t.Errorf("expected deploy tracking type BUILD, got %q", input.DeployTrackingType)
+	}
+	if len(input.BuildMappings) != 1 || input.BuildMappings[0].Provider != "CIRCLECI" {
+		t.Errorf("expected a CIRCLECI build mapping, got %+v", input.BuildMappings)
+	}
+	if len(input.EnvironmentMappings) != 2 {
+		t.Errorf("expected the updated environment mappings, got %+v", input.EnvironmentMappings)
+	}
+}
+
+func TestUpdateCodeChangeSourceMappingsRetriesUndoneUpdates(t *testing.T) {
+	tests := []struct {
+		name              string
+		undone            func(mutation int) bool
+		expectedMutations int
+		expectedError     string
+	}{
+		{
+			name:              "saved",
+			undone:            func(int) bool { return false },
+			expectedMutations: 1,
+		},
+		{
+			name:              "undone once",
+			undone:            func(mutation int) bool { return mutation == 1 },
+			expectedMutations: 2,
+		},
+		{
+			name:              "always undone",
+			undone:            func(int) bool { return true },
+			expectedMutations: maxMappingUpdateAttempts,
+			expectedError:     "mappings of code change source api were changed by another writer while saving them, gave up after 3 attempts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, inputs := newCodeChangeSourceServer(t, tt.undone)
+
+			ccs, err := client.UpdateCodeChangeSourceMappings(context.Background(), "payments", "api", addStagingMapping)
+			if len(*inputs) != tt.expectedMutations {
+				t.Errorf("expected %d mutations, got %d", tt.expectedMutations, len(*inputs))
+			}
+			if tt.expectedError != "" {
+				if err == nil || err.Error() != tt.expectedError {
+					t.Errorf("expected error %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ccs.EnvironmentMappings) != 2 {
+				t.Errorf("expected the staging mapping to be saved, got %+v", ccs.EnvironmentMappings)
+			}
+		})
+	}
+}
@@ -2,32 +2,29 @@ package gqlclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/shurcooL/graphql"
 )
 
-var ErrNotFound = errors.New("Resource was not found")
-
-func (c *Client) GetEnvironmentByName(ctx context.Context, projectSlug *string, name *string) (*Environment, error) {
-	var query struct {
-		Project struct {
-			Environments []Environment
-		} `graphql:"project(projectSlug: $projectSlug)"`
-	}
-	variables := map[string]interface{}{
-		"projectSlug": graphql.ID(*projectSlug),
+// getProjectEnvironments - Returns all environments of the project, shared by concurrent callers
+func (c *Client) getProjectEnvironments(ctx context.Context, projectSlug string) ([]Environment, error) {
+	project, err := c.getProjectSections(ctx, projectSlug)
+	if err != nil {
+		return nil, err
 	}
+	return project.Environments, nil
+}
 
-	err := c.doQuery(ctx, &query, variables)
+func (c *Client) GetEnvironmentByName(ctx context.Context, projectSlug *string, name *string) (*Environment, error) {
+	environments, err := c.getProjectEnvironments(ctx, *projectSlug)
 
 	if err != nil {
 		return nil, err
 	}
 
-	for _, env := range query.Project.Environments {
+	for _, env := range environments {
 		if env.Name == *name {
 			return &env, nil
 		}
@@ -37,27 +34,18 @@ func (c *Client) GetEnvironmentByName(ctx context.Context, projectSlug *string,
 
 // GetEnvironment - Returns environment
 func (c *Client) GetEnvironment(ctx context.Context, projectSlug *string, slug *string) (*Environment, error) {
-	var query struct {
-		Project struct {
-			Environments []Environment
-		} `graphql:"project(projectSlug: $projectSlug)"`
-	}
-	variables := map[string]interface{}{
-		"projectSlug": graphql.ID(*projectSlug),
-	}
-
-	err := c.doQuery(ctx, &query, variables)
+	environments, err := c.getProjectEnvironments(ctx, *projectSlug)
 
 	if err != nil {
 		return nil, err
 	}
 
-	for _, env := range query.Project.Environments {
+	for _, env := range environments {
 		if env.Slug == *slug {
 			return &env, nil
 		}
 	}
-	return nil, nil
+	return nil, ErrNotFound
 }
 
 // CreateEnvironment - Creates a environment
@@ -80,7 +68,7 @@ func (c *Client) CreateEnvironment(ctx context.Context, input CreateEnvironmentM
 	}
 
 	if len(m.CreateEnvironment.Errors) > 0 {
-		return nil, fmt.Errorf("errors creating environment: %+v", m.CreateEnvironment.Errors)
+		return nil, newMutationError("creating environment", m.CreateEnvironment.Errors)
 	}
 	return &m.CreateEnvironment.Environment, nil
 }
@@ -105,7 +93,7 @@ func (c *Client) UpdateEnvironment(ctx context.Context, input UpdateEnvironmentM
 	}
 
 	if len(m.UpdateEnvironment.Errors) > 0 {
-		return nil, errors.New("Errors updating environment")
+		return nil, newMutationError("updating environment", m.UpdateEnvironment.Errors)
 	}
 
 	return &m.UpdateEnvironment.Environment, nil
@@ -139,7 +127,10 @@ func (c *Client) DeleteEnvironment(ctx context.Context, projectSlug *string, slu
 	}
 
 	if !m.DeleteEnvironment.Success {
-		return fmt.Errorf("deleting environment was not successful: %+v", m.DeleteEnvironment.Errors)
+		if len(m.DeleteEnvironment.Errors) > 0 {
+			return newMutationError("deleting environment", m.DeleteEnvironment.Errors)
+		}
+		return fmt.Errorf("deleting environment was not successful")
 	} else {
 		return nil
 	}
@@ -2,39 +2,53 @@ package gqlclient
 
 import (
 	"context"
-	"errors"
-	"fmt"
-
-	"github.com/shurcooL/graphql"
 )
 
+// getProjectErrorImpactSources - Returns all error impact sources of the project, shared by concurrent callers
+func (c *Client) getProjectErrorImpactSources(ctx context.Context, projectSlug string) ([]ErrorImpactSource, error) {
+	project, err := c.getProjectSections(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	var sources []ErrorImpactSource
+	for _, src := range project.ImpactSources {
+		if src.Type == "ERROR" {
+			sources = append(sources, src.Error)
+		}
+	}
+	return sources, nil
+}
+
 // GetErrorImpactSource - Returns error impact source
 func (c *Client) GetErrorImpactSource(ctx context.Context, projectSlug *string, slug *string) (*ErrorImpactSource, error) {
-	var query struct {
-		Project struct {
-			ImpactSources []struct {
-				Type         graphql.String
-				ImpactSource ErrorImpactSource `graphql:"... on ErrorImpactSource"`
-			} `graphql:"impactSources(impactSourceSlug: $impactSourceSlug)"`
-		} `graphql:"project(projectSlug: $projectSlug)"`
+	sources, err := c.getProjectErrorImpactSources(ctx, *projectSlug)
+
+	if err != nil {
+		return nil, err
 	}
-	variables := map[string]interface{}{
-		"projectSlug":      graphql.ID(*projectSlug),
-		"impactSourceSlug": graphql.ID(*slug),
+
+	for _, src := range sources {
+		if src.Slug == *slug {
+			return &src, nil
+		}
 	}
+	return nil, ErrNotFound
+}
 
-	err := c.doQuery(ctx, &query, variables)
+// GetErrorImpactSourceByName - Returns the error impact source with the given name
+func (c *Client) GetErrorImpactSourceByName(ctx context.Context, projectSlug *string, name *string) (*ErrorImpactSource, error) {
+	sources, err := c.getProjectErrorImpactSources(ctx, *projectSlug)
 
 	if err != nil {
 		return nil, err
 	}
 
-	for _, src := range query.Project.ImpactSources {
-		if src.Type == "ERROR" {
-			return &src.ImpactSource, nil
+	for _, src := range sources {
+		if src.Name == *name {
+			return &src, nil
 		}
 	}
-	return nil, nil
+	return nil, ErrNotFound
 }
 
 // CreateErrorImpactSource - Creates a environment
@@ -57,7 +71,7 @@ func (c *Client) CreateErrorImpactSource(ctx context.Context, input CreateErrorI
 	}
 
 	if len(m.CreateErrorImpactSource.Errors) > 0 {
-		return nil, fmt.Errorf("errors creating impact source: %+v", m.CreateErrorImpactSource.Errors)
+		return nil, newMutationError("creating impact source", m.CreateErrorImpactSource.Errors)
 	}
 	return &m.CreateErrorImpactSource.ImpactSource, nil
 }
@@ -82,7 +96,7 @@ func (c *Client) UpdateErrorImpactSource(ctx context.Context, input UpdateErrorI
 	}
 
 	if len(m.UpdateErrorImpactSource.Errors) > 0 {
-		return nil, errors.New("Errors updating impact source")
+		return nil, newMutationError("updating impact source", m.UpdateErrorImpactSource.Errors)
 	}
 
 	return &m.UpdateErrorImpactSource.ImpactSource, nil
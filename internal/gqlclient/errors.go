@@ -0,0 +1,54 @@
+package gqlclient
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotFound is returned by getters when the requested object does not exist
+var ErrNotFound = errors.New("Resource was not found")
+
+// isNotFoundError reports whether the API rejected a query because the object it looks up, like a "project", does not
+// exist. Only the message naming that object counts, so an error about another missing object is not mistaken for it.
+func isNotFoundError(err error, object string) bool {
+	return isNotFoundMessage(err.Error(), object)
+}
+
+// isNotFoundMessage reports whether an API error message says that object does not exist, as in "Project not found"
+func isNotFoundMessage(message, object string) bool {
+	return strings.EqualFold(strings.TrimSpace(message), object+" not found")
+}
+
+// FieldError is a validation error returned by a mutation. Field is empty for errors not tied to an input field.
+type FieldError struct {
+	Field    string   `json:"field"`
+	Messages []string `json:"messages"`
+}
+
+func (e FieldError) String() string {
+	messages := strings.Join(e.Messages, " ")
+	if e.Field == "" {
+		return messages
+	}
+	return fmt.Sprintf("%s: %s", e.Field, messages)
+}
+
+// MutationError is returned when a mutation succeeded at the transport level but the API rejected the input
+type MutationError struct {
+	// Operation describes what was attempted, e.g. "creating project"
+	Operation string
+	Errors    ErrorsType
+}
+
+func newMutationError(operation string, errors ErrorsType) *MutationError {
+	return &MutationError{Operation: operation, Errors: errors}
+}
+
+func (e *MutationError) Error() string {
+	var details []string
+	for _, fieldError := range e.Errors {
+		details = append(details, fieldError.String())
+	}
+	return fmt.Sprintf("errors %s: %s", e.Operation, strings.Join(details, "; "))
+}
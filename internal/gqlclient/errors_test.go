@@ -0,0 +1,29 @@
+package gqlclient
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := []struct {
+		message  string
+		object   string
+		expected bool
+	}{
+		{message: "Project not found", object: "project", expected: true},
+		{message: "project not found", object: "project", expected: true},
+		{message: "Team not found", object: "team", expected: true},
+		{message: "Organization not found", object: "team", expected: false},
+		{message: "Environment staging not found", object: "project", expected: false},
+		{message: "Integration datadog referenced by the source was not found", object: "project", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			if actual := isNotFoundError(errors.New(tt.message), tt.object); actual != tt.expected {
+				t.Errorf("expected %v for %q looking up a %s, got %v", tt.expected, tt.message, tt.object, actual)
+			}
+		})
+	}
+}
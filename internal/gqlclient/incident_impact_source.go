@@ -5,34 +5,51 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/shurcooL/graphql"
 )
 
+// getProjectIncidentImpactSources - Returns all incident impact sources of the project, shared by concurrent callers
+func (c *Client) getProjectIncidentImpactSources(ctx context.Context, projectSlug string) ([]IncidentImpactSource, error) {
+	project, err := c.getProjectSections(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	var sources []IncidentImpactSource
+	for _, src := range project.ImpactSources {
+		if src.Type == "INCIDENT" {
+			sources = append(sources, src.Incident)
+		}
+	}
+	return sources, nil
+}
+
 // GetIncidentImpactSource returns incident impact source
 func (c *Client) GetIncidentImpactSource(ctx context.Context, projectSlug, slug string) (*IncidentImpactSource, error) {
-	var query struct {
-		Project struct {
-			ImpactSources []struct {
-				ImpactSource IncidentImpactSource `graphql:"... on IncidentImpactSource" json:"impactSource"`
-			} `graphql:"impactSources(impactSourceSlug: $impactSourceSlug)"`
-		} `graphql:"project(projectSlug: $projectSlug)"`
+	sources, err := c.getProjectIncidentImpactSources(ctx, projectSlug)
+	if err != nil {
+		return nil, err
 	}
-	variables := map[string]interface{}{
-		"projectSlug":      graphql.ID(projectSlug),
-		"impactSourceSlug": graphql.ID(slug),
+
+	for _, src := range sources {
+		if src.Slug == slug {
+			return &src, nil
+		}
 	}
+	return nil, ErrNotFound
+}
 
-	err := c.doQuery(ctx, &query, variables)
-	tflog.Error(ctx, fmt.Sprintf("Error when calling GraphQL, %+v", err))
+// GetIncidentImpactSourceByName returns the incident impact source with the given name
+func (c *Client) GetIncidentImpactSourceByName(ctx context.Context, projectSlug, name string) (*IncidentImpactSource, error) {
+	sources, err := c.getProjectIncidentImpactSources(ctx, projectSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(query.Project.ImpactSources) > 1 {
-		tflog.Warn(ctx, "More than one impact source found", map[string]interface{}{"slug": slug, "projectSlug": projectSlug})
+	for _, src := range sources {
+		if src.Name == name {
+			return &src, nil
+		}
 	}
-	return &query.Project.ImpactSources[0].ImpactSource, nil
-
+	return nil, ErrNotFound
 }
 
 func (c *Client) CreateIncidentImpactSource(ctx context.Context, input IncidentImpactSourceInputType) (*IncidentImpactSource, error) {
@@ -55,7 +72,7 @@ func (c *Client) CreateIncidentImpactSource(ctx context.Context, input IncidentI
 
 	if len(m.CreateIncidentImpactSource.Errors) > 0 {
 		tflog.Error(ctx, fmt.Sprintf("%+v", m.CreateIncidentImpactSource.Errors))
-		return nil, fmt.Errorf("Error creating incident impact source:  %+v", m.CreateIncidentImpactSource.Errors)
+		return nil, newMutationError("creating incident impact source", m.CreateIncidentImpactSource.Errors)
 	}
 
 	return &m.CreateIncidentImpactSource.ImpactSource, nil
@@ -81,7 +98,7 @@ func (c *Client) UpdateIncidentImpactSource(ctx context.Context, input IncidentI
 
 	if len(m.UpdateIncidentImpactSource.Errors) > 0 {
 		tflog.Error(ctx, fmt.Sprintf("%+v", m.UpdateIncidentImpactSource.Errors))
-		return nil, fmt.Errorf("Error creating incident impact source:  %+v", m.UpdateIncidentImpactSource.Errors)
+		return nil, newMutationError("updating incident impact source", m.UpdateIncidentImpactSource.Errors)
 	}
 
 	return &m.UpdateIncidentImpactSource.ImpactSource, nil
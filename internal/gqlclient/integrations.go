@@ -0,0 +1,121 @@
+package gqlclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/graphql"
+)
+
+// GetIntegrations - Returns the integration authentications of the organization
+func (c *Client) GetIntegrations(ctx context.Context) ([]IntegrationAuthentication, error) {
+	var query struct {
+		Organization struct {
+			IntegrationAuths []IntegrationAuthentication `graphql:"integrationAuths"`
+		} `graphql:"organization(orgSlug: $orgSlug)"`
+	}
+	variables := map[string]interface{}{
+		"orgSlug": graphql.ID(c.OrgSlug),
+	}
+	err := c.doQuery(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	return query.Organization.IntegrationAuths, nil
+}
+
+// GetIntegration - Returns the integration authentication with the given slug
+func (c *Client) GetIntegration(ctx context.Context, slug string) (*IntegrationAuthentication, error) {
+	integrations, err := c.GetIntegrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, integration := range integrations {
+		if integration.Slug == slug {
+			return &integration, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// CreateIntegrationAuth - Connects an integration with the given credentials
+func (c *Client) CreateIntegrationAuth(ctx context.Context, input CreateIntegrationAuthMutationInput) (*IntegrationAuthentication, error) {
+	var m struct {
+		CreateIntegrationAuth struct {
+			IntegrationAuth IntegrationAuthentication
+			Errors          ErrorsType
+		} `graphql:"createIntegrationAuth(input: $input)"`
+	}
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.doMutate(ctx, &m, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.CreateIntegrationAuth.Errors) > 0 {
+		return nil, newMutationError("creating integration authentication", m.CreateIntegrationAuth.Errors)
+	}
+	return &m.CreateIntegrationAuth.IntegrationAuth, nil
+}
+
+// UpdateIntegrationAuth - Replaces the credentials of an integration, e.g. to rotate them
+func (c *Client) UpdateIntegrationAuth(ctx context.Context, input UpdateIntegrationAuthMutationInput) (*IntegrationAuthentication, error) {
+	var m struct {
+		UpdateIntegrationAuth struct {
+			IntegrationAuth IntegrationAuthentication
+			Errors          ErrorsType
+		} `graphql:"updateIntegrationAuth(input: $input)"`
+	}
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.doMutate(ctx, &m, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.UpdateIntegrationAuth.Errors) > 0 {
+		return nil, newMutationError("updating integration authentication", m.UpdateIntegrationAuth.Errors)
+	}
+	return &m.UpdateIntegrationAuth.IntegrationAuth, nil
+}
+
+// DeleteIntegrationAuth - Disconnects an integration. An integration the API reports as missing is reported as
+// ErrNotFound, any other refusal, like one still in use, as an error.
+func (c *Client) DeleteIntegrationAuth(ctx context.Context, slug string) error {
+	var m struct {
+		DeleteIntegrationAuth struct {
+			Success graphql.Boolean
+			Errors  ErrorsType
+		} `graphql:"deleteIntegrationAuth(input: $input)"`
+	}
+	variables := map[string]interface{}{
+		"input": DeleteIntegrationAuthMutationInput{Slug: slug},
+	}
+
+	err := c.doMutate(ctx, &m, variables)
+	if err != nil {
+		if isNotFoundError(err, "integration") {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	if m.DeleteIntegrationAuth.Success {
+		return nil
+	}
+	for _, fieldError := range m.DeleteIntegrationAuth.Errors {
+		if fieldError.Field == "slug" && isNotFoundMessage(strings.Join(fieldError.Messages, " "), "integration") {
+			return ErrNotFound
+		}
+	}
+	if len(m.DeleteIntegrationAuth.Errors) > 0 {
+		return newMutationError("deleting integration authentication", m.DeleteIntegrationAuth.Errors)
+	}
+	return fmt.Errorf("deleting integration authentication %s was not successful", slug)
+}
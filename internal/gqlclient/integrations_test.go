@@ -0,0 +1,67 @@
+package gqlclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeleteIntegrationAuth(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      string
+		expectedError string
+		notFound      bool
+	}{
+		{
+			name:     "deleted",
+			response: `{"success": true, "errors": []}`,
+		},
+		{
+			name:     "missing integration",
+			response: `{"success": false, "errors": [{"field": "slug", "messages": ["Integration not found"]}]}`,
+			notFound: true,
+		},
+		{
+			name:          "integration in use",
+			response:      `{"success": false, "errors": [{"field": "slug", "messages": ["Integration is used by 2 impact sources"]}]}`,
+			expectedError: "errors deleting integration authentication: slug: Integration is used by 2 impact sources",
+		},
+		{
+			name:          "refused without errors",
+			response:      `{"success": false, "errors": []}`,
+			expectedError: "deleting integration authentication datadog was not successful",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data": {"deleteIntegrationAuth": ` + tt.response + `}}`))
+			}))
+			t.Cleanup(server.Close)
+
+			apiKey := "key"
+			client, err := NewClient(&server.URL, &apiKey, "acme", "test", 0, RetryPolicy{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = client.DeleteIntegrationAuth(context.Background(), "datadog")
+			switch {
+			case tt.notFound:
+				if !errors.Is(err, ErrNotFound) {
+					t.Errorf("expected ErrNotFound, got %v", err)
+				}
+			case tt.expectedError == "":
+				if err != nil {
+					t.Errorf("unexpected error %v", err)
+				}
+			case err == nil || errors.Is(err, ErrNotFound) || err.Error() != tt.expectedError:
+				t.Errorf("expected error %q, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -2,39 +2,53 @@ package gqlclient
 
 import (
 	"context"
-	"errors"
-	"fmt"
-
-	"github.com/shurcooL/graphql"
 )
 
-// GetMetricImpactSource - Returns error impact source
+// getProjectMetricImpactSources - Returns all metric impact sources of the project, shared by concurrent callers
+func (c *Client) getProjectMetricImpactSources(ctx context.Context, projectSlug string) ([]MetricImpactSource, error) {
+	project, err := c.getProjectSections(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	var sources []MetricImpactSource
+	for _, src := range project.ImpactSources {
+		if src.Type == "METRIC" {
+			sources = append(sources, src.Metric)
+		}
+	}
+	return sources, nil
+}
+
+// GetMetricImpactSource - Returns metric impact source
 func (c *Client) GetMetricImpactSource(ctx context.Context, projectSlug *string, slug *string) (*MetricImpactSource, error) {
-	var query struct {
-		Project struct {
-			ImpactSources []struct {
-				Type         graphql.String
-				ImpactSource MetricImpactSource `graphql:"... on MetricImpactSource"`
-			} `graphql:"impactSources(impactSourceSlug: $impactSourceSlug)"`
-		} `graphql:"project(projectSlug: $projectSlug)"`
+	sources, err := c.getProjectMetricImpactSources(ctx, *projectSlug)
+
+	if err != nil {
+		return nil, err
 	}
-	variables := map[string]interface{}{
-		"projectSlug":      graphql.ID(*projectSlug),
-		"impactSourceSlug": graphql.ID(*slug),
+
+	for _, src := range sources {
+		if src.Slug == *slug {
+			return &src, nil
+		}
 	}
+	return nil, ErrNotFound
+}
 
-	err := c.doQuery(ctx, &query, variables)
+// GetMetricImpactSourceByName - Returns the metric impact source with the given name
+func (c *Client) GetMetricImpactSourceByName(ctx context.Context, projectSlug *string, name *string) (*MetricImpactSource, error) {
+	sources, err := c.getProjectMetricImpactSources(ctx, *projectSlug)
 
 	if err != nil {
 		return nil, err
 	}
 
-	for _, src := range query.Project.ImpactSources {
-		if src.Type == "METRIC" {
-			return &src.ImpactSource, nil
+	for _, src := range sources {
+		if src.Name == *name {
+			return &src, nil
 		}
 	}
-	return nil, nil
+	return nil, ErrNotFound
 }
 
 // CreateMetricImpactSource - Creates a environment
@@ -57,7 +71,7 @@ func (c *Client) CreateMetricImpactSource(ctx context.Context, input CreateMetri
 	}
 
 	if len(m.CreateMetricImpactSource.Errors) > 0 {
-		return nil, fmt.Errorf("errors creating impact source: %+v", m.CreateMetricImpactSource.Errors)
+		return nil, newMutationError("creating impact source", m.CreateMetricImpactSource.Errors)
 	}
 	return &m.CreateMetricImpactSource.ImpactSource, nil
 }
@@ -82,7 +96,7 @@ func (c *Client) UpdateMetricImpactSource(ctx context.Context, input UpdateMetri
 	}
 
 	if len(m.UpdateMetricImpactSource.Errors) > 0 {
-		return nil, errors.New("Errors updating impact source")
+		return nil, newMutationError("updating impact source", m.UpdateMetricImpactSource.Errors)
 	}
 
 	return &m.UpdateMetricImpactSource.ImpactSource, nil
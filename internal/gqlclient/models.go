@@ -73,6 +73,68 @@ type IntegrationAuth struct {
 	Slug string `json:"slug"`
 }
 
+// IntegrationAuthentication is a connection to a third-party service, like Datadog or PagerDuty. Resources refer to it
+// by its slug, which Sleuth derives from the provider and the description label.
+type IntegrationAuthentication struct {
+	Slug     string `json:"slug"`
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	Label    string `json:"label"`
+}
+
+// IntegrationAuthCredentials are the credentials of an integration authentication, with the block of its provider set.
+// Sleuth never returns them.
+type IntegrationAuthCredentials struct {
+	Datadog    *DatadogCredentials    `json:"datadog,omitempty"`
+	PagerDuty  *PagerDutyCredentials  `json:"pagerDuty,omitempty"`
+	Sentry     *SentryCredentials     `json:"sentry,omitempty"`
+	Jenkins    *JenkinsCredentials    `json:"jenkins,omitempty"`
+	CloudWatch *CloudWatchCredentials `json:"cloudWatch,omitempty"`
+}
+
+type DatadogCredentials struct {
+	APIKey string `json:"apiKey"`
+	AppKey string `json:"appKey"`
+	Site   string `json:"site,omitempty"`
+}
+
+type PagerDutyCredentials struct {
+	APIKey string `json:"apiKey"`
+}
+
+type SentryCredentials struct {
+	AuthToken        string `json:"authToken"`
+	OrganizationSlug string `json:"organizationSlug"`
+	Url              string `json:"url,omitempty"`
+}
+
+type JenkinsCredentials struct {
+	Url      string `json:"url"`
+	Username string `json:"username"`
+	APIToken string `json:"apiToken"`
+}
+
+type CloudWatchCredentials struct {
+	AccessKeyID     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	Region          string `json:"region"`
+}
+
+type CreateIntegrationAuthMutationInput struct {
+	Provider string `json:"provider"`
+	Label    string `json:"label,omitempty"`
+	IntegrationAuthCredentials
+}
+
+type UpdateIntegrationAuthMutationInput struct {
+	Slug string `json:"slug"`
+	IntegrationAuthCredentials
+}
+
+type DeleteIntegrationAuthMutationInput struct {
+	Slug string `json:"slug"`
+}
+
 type MutableRepository struct {
 	RepositoryBase
 	IntegrationSlug string `json:"integrationSlug,omitempty"`
@@ -216,6 +278,7 @@ type DeployTrackingBuildMapping struct {
 	BuildName                string      `json:"buildName"`
 	JobName                  string      `json:"jobName,omitempty"`
 	BuildProjectKey          string      `json:"buildProjectKey"`
+	BuildProjectName         string      `json:"buildProjectName"`
 	MatchBranchToEnvironment bool        `json:"matchBranchToEnvironment"`
 	IsCustom                 bool        `json:"isCustom"`
 }
@@ -234,8 +297,9 @@ type MutableCodeChangeSource struct {
 }
 
 type CreateCodeChangeSourceMutationInput struct {
-	ProjectSlug       string `json:"projectSlug"`
-	InitializeChanges bool   `json:"initializeChanges"`
+	ProjectSlug        string `json:"projectSlug"`
+	InitializeChanges  bool   `json:"initializeChanges"`
+	InitialHistoryDays *int   `json:"initialHistoryDays,omitempty"`
 	*MutableCodeChangeSource
 }
 
@@ -250,10 +314,7 @@ type DeleteChangeSourceMutationInput struct {
 	Slug        string `json:"slug"`
 }
 
-type ErrorsType []struct {
-	Field    string   `json:"field"`
-	Messages []string `json:"messages"`
-}
+type ErrorsType []FieldError
 
 type PagerDutyProviderData struct {
 	RemoteServices string `json:"remoteServices"`
@@ -401,6 +462,12 @@ type IncidentImpactSourceDeleteInputType struct {
 
 // Team models and mutation inputs/outputs
 
+type Organization struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
 type Team struct {
 	ID     string `json:"id"`
 	Slug   string `json:"slug"`
@@ -0,0 +1,36 @@
+package gqlclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/graphql"
+)
+
+// GetOrganization - Returns the configured organization, or the one the API key belongs to when the organization slug
+// is not known yet
+func (c *Client) GetOrganization(ctx context.Context) (*Organization, error) {
+	if c.OrgSlug == "" {
+		var query struct {
+			Organization Organization `graphql:"organization"`
+		}
+		if err := c.doQuery(ctx, &query, nil); err != nil {
+			return nil, err
+		}
+		if query.Organization.Slug == "" {
+			return nil, fmt.Errorf("no organization found for the API key")
+		}
+		return &query.Organization, nil
+	}
+
+	var query struct {
+		Organization Organization `graphql:"organization(orgSlug: $orgSlug)"`
+	}
+	variables := map[string]interface{}{
+		"orgSlug": graphql.ID(c.OrgSlug),
+	}
+	if err := c.doQuery(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+	return &query.Organization, nil
+}
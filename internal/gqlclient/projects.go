@@ -4,37 +4,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/shurcooL/graphql"
 )
 
-//// GetProjects - Returns list of projects
-//func (c *Client) GetProjects() ([]Project, error) {
-//	var query struct {
-//		Projects []struct {
-//			Name graphql.String
-//			Slug graphql.String
-//		} `graphql:"projects(orgSlug: $orgSlug)"`
-//	}
-//	variables := map[string]interface{}{
-//		"orgSlug":   graphql.ID(c.OrgSlug),
-//	}
-//
-//	err := c.doQuery(ctx, &query, variables)
-//
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	projects := []Project{}
-//	for _, element  := range query.Projects{
-//		project := Project{Name: string(element.Slug)}
-//		projects = append(projects, project)
-//	}
-//
-//	return projects, nil
-//}
+const projectsPageSize = 50
+
+// GetProjects - Returns all projects of the organization, following pagination until the last page
+func (c *Client) GetProjects(ctx context.Context) ([]Project, error) {
+	var projects []Project
+	for page := 1; ; page++ {
+		var query struct {
+			Projects struct {
+				Objects []Project
+				HasNext graphql.Boolean
+			} `graphql:"projects(orgSlug: $orgSlug, page: $page, pageSize: $pageSize)"`
+		}
+		variables := map[string]interface{}{
+			"orgSlug":  graphql.ID(c.OrgSlug),
+			"page":     graphql.Int(page),
+			"pageSize": graphql.Int(projectsPageSize),
+		}
+		err := c.doQuery(ctx, &query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, query.Projects.Objects...)
+		if !query.Projects.HasNext || len(query.Projects.Objects) == 0 {
+			break
+		}
+	}
+
+	return projects, nil
+}
 
 // GetProject - Returns project
 func (c *Client) GetProject(ctx context.Context, slug *string) (*Project, error) {
@@ -47,8 +50,8 @@ func (c *Client) GetProject(ctx context.Context, slug *string) (*Project, error)
 	err := c.doQuery(ctx, &query, variables)
 
 	if err != nil {
-		if strings.HasSuffix(err.Error(), "not found") {
-			return nil, nil
+		if isNotFoundError(err, "project") {
+			return nil, ErrNotFound
 		}
 		return nil, err
 	}
@@ -82,7 +85,7 @@ func (c *Client) CreateProject(ctx context.Context, input CreateProjectMutationI
 	}
 
 	if len(m.CreateProject.Errors) > 0 {
-		return nil, fmt.Errorf("%+v", m.CreateProject.Errors)
+		return nil, newMutationError("creating project", m.CreateProject.Errors)
 	}
 	return &m.CreateProject.Project, nil
 }
@@ -107,7 +110,7 @@ func (c *Client) UpdateProject(ctx context.Context, slug *string, input UpdatePr
 	}
 
 	if len(m.UpdateProject.Errors) > 0 {
-		return nil, fmt.Errorf("%+v", m.UpdateProject.Errors)
+		return nil, newMutationError("updating project", m.UpdateProject.Errors)
 	}
 
 	return &m.UpdateProject.Project, nil
@@ -0,0 +1,196 @@
+package gqlclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryMaxWait = 60 * time.Second
+
+	retryBaseDelay    = 500 * time.Millisecond
+	retryMaxBackoffAt = 10
+)
+
+type mutationContextKey struct{}
+
+// withMutation marks the request as a mutation, so the retrying transport only retries it when the server
+// could not have processed it
+func withMutation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mutationContextKey{}, true)
+}
+
+func isMutation(ctx context.Context) bool {
+	mutation, _ := ctx.Value(mutationContextKey{}).(bool)
+	return mutation
+}
+
+// RetryPolicy configures how failed requests are retried
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+	// MaxWait caps the total time spent retrying, counted from the start of the first attempt. No retry is started
+	// once its backoff would end past it.
+	MaxWait time.Duration
+}
+
+// RetryTransport retries requests that failed with a network error, a rate limit or a gateway error, using
+// exponential backoff with full jitter and honouring Retry-After. Every attempt gets its own Timeout, so a request
+// takes at most Policy.MaxWait plus Timeout.
+type RetryTransport struct {
+	T       http.RoundTripper
+	Policy  RetryPolicy
+	Timeout time.Duration
+
+	// sleep and now are replaced in tests
+	sleep func(ctx context.Context, d time.Duration) error
+	now   func() time.Time
+}
+
+func (transport *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := req.Context()
+	mutation := isMutation(ctx)
+	deadline := transport.clock().Add(transport.Policy.MaxWait)
+	for attempt := 0; ; attempt++ {
+		res, err := transport.attempt(req, body)
+
+		if attempt >= transport.Policy.MaxRetries || !shouldRetry(res, err, mutation) {
+			return res, err
+		}
+
+		wait := backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(res); ok {
+			wait = retryAfter
+		}
+		if transport.clock().Add(wait).After(deadline) {
+			return res, err
+		}
+		if res != nil {
+			// drain so the connection can be reused
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if err := transport.wait(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (transport *RetryTransport) attempt(req *http.Request, body []byte) (*http.Response, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if transport.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, transport.Timeout)
+	}
+	attemptReq := req.Clone(ctx)
+	if body != nil {
+		attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		attemptReq.ContentLength = int64(len(body))
+	}
+
+	res, err := transport.T.RoundTrip(attemptReq)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the attempt's deadline has to outlive RoundTrip until the body is read
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+func (transport *RetryTransport) clock() time.Time {
+	if transport.now != nil {
+		return transport.now()
+	}
+	return time.Now()
+}
+
+func (transport *RetryTransport) wait(ctx context.Context, d time.Duration) error {
+	if transport.sleep != nil {
+		return transport.sleep(ctx, d)
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// shouldRetry decides whether an attempt is retried. Mutations are only retried when the request was rejected
+// before being processed, i.e. when rate limited, since replaying them could apply a change twice.
+func shouldRetry(res *http.Response, err error, mutation bool) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+		return !mutation
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return !mutation
+	}
+	return false
+}
+
+// backoff returns a random delay between 0 and retryBaseDelay * 2^attempt
+func backoff(attempt int) time.Duration {
+	if attempt > retryMaxBackoffAt {
+		attempt = retryMaxBackoffAt
+	}
+	return time.Duration(rand.Int63n(int64(retryBaseDelay << attempt)))
+}
+
+// parseRetryAfter reads the Retry-After header, given either in seconds or as an HTTP date
+func parseRetryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (body *cancelOnCloseBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.cancel()
+	return err
+}
@@ -0,0 +1,192 @@
+package gqlclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestRetryClient(policy RetryPolicy, waits *[]time.Duration) *http.Client {
+	return &http.Client{Transport: &RetryTransport{
+		T:      http.DefaultTransport,
+		Policy: policy,
+		sleep: func(_ context.Context, d time.Duration) error {
+			*waits = append(*waits, d)
+			return nil
+		},
+	}}
+}
+
+func newStatusSequenceServer(t *testing.T, statuses []int, retryAfter string) (*httptest.Server, *int) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"query":"q"}` {
+			t.Errorf("attempt %d got body %q", calls, body)
+		}
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		if status != http.StatusOK && retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func doTestRequest(t *testing.T, client *http.Client, url string, mutation bool) int {
+	ctx := context.Background()
+	if mutation {
+		ctx = withMutation(ctx)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(`{"query":"q"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	return res.StatusCode
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name           string
+		statuses       []int
+		retryAfter     string
+		mutation       bool
+		policy         RetryPolicy
+		expectedStatus int
+		expectedCalls  int
+	}{
+		{
+			name:           "success is not retried",
+			statuses:       []int{http.StatusOK},
+			policy:         RetryPolicy{MaxRetries: 3, MaxWait: time.Minute},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+		},
+		{
+			name:           "gateway errors are retried for queries",
+			statuses:       []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			policy:         RetryPolicy{MaxRetries: 3, MaxWait: time.Minute},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  3,
+		},
+		{
+			name:           "gives up after max retries",
+			statuses:       []int{http.StatusBadGateway},
+			policy:         RetryPolicy{MaxRetries: 2, MaxWait: time.Minute},
+			expectedStatus: http.StatusBadGateway,
+			expectedCalls:  3,
+		},
+		{
+			name:           "gateway errors are not retried for mutations",
+			statuses:       []int{http.StatusBadGateway, http.StatusOK},
+			mutation:       true,
+			policy:         RetryPolicy{MaxRetries: 3, MaxWait: time.Minute},
+			expectedStatus: http.StatusBadGateway,
+			expectedCalls:  1,
+		},
+		{
+			name:           "rate limits are retried for mutations",
+			statuses:       []int{http.StatusTooManyRequests, http.StatusOK},
+			mutation:       true,
+			policy:         RetryPolicy{MaxRetries: 3, MaxWait: time.Minute},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  2,
+		},
+		{
+			name:           "retry-after beyond max wait stops retrying",
+			statuses:       []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:     "120",
+			policy:         RetryPolicy{MaxRetries: 3, MaxWait: time.Minute},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCalls:  1,
+		},
+		{
+			name:           "client errors are not retried",
+			statuses:       []int{http.StatusBadRequest, http.StatusOK},
+			policy:         RetryPolicy{MaxRetries: 3, MaxWait: time.Minute},
+			expectedStatus: http.StatusBadRequest,
+			expectedCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newStatusSequenceServer(t, tt.statuses, tt.retryAfter)
+			var waits []time.Duration
+			client := newTestRetryClient(tt.policy, &waits)
+
+			status := doTestRequest(t, client, server.URL, tt.mutation)
+
+			if status != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, status)
+			}
+			if *calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, *calls)
+			}
+		})
+	}
+}
+
+func TestRetryTransportHonoursRetryAfter(t *testing.T) {
+	server, _ := newStatusSequenceServer(t, []int{http.StatusTooManyRequests, http.StatusOK}, "7")
+	var waits []time.Duration
+	client := newTestRetryClient(RetryPolicy{MaxRetries: 3, MaxWait: time.Minute}, &waits)
+
+	doTestRequest(t, client, server.URL, false)
+
+	if len(waits) != 1 || waits[0] != 7*time.Second {
+		t.Errorf("expected a single 7s wait, got %v", waits)
+	}
+}
+
+func TestRetryTransportCountsAttemptsInMaxWait(t *testing.T) {
+	now := time.Now()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// every attempt takes 30s
+		now = now.Add(30 * time.Second)
+		calls++
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: &RetryTransport{
+		T:      http.DefaultTransport,
+		Policy: RetryPolicy{MaxRetries: 5, MaxWait: time.Minute},
+		sleep: func(_ context.Context, d time.Duration) error {
+			now = now.Add(d)
+			return nil
+		},
+		now: func() time.Time { return now },
+	}}
+
+	status := doTestRequest(t, client, server.URL, false)
+
+	// the second attempt ends 65s after the first started, past the 60s max wait
+	if status != http.StatusServiceUnavailable || calls != 2 {
+		t.Errorf("expected 2 calls ending with 503, got %d calls ending with %d", calls, status)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 0; attempt < 20; attempt++ {
+		wait := backoff(attempt)
+		if wait < 0 || wait > retryBaseDelay<<retryMaxBackoffAt {
+			t.Errorf("attempt %d: backoff %s out of range", attempt, wait)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package gqlclient
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	nonSlugCharacters = regexp.MustCompile(`[^\w\s-]`)
+	slugSeparators    = regexp.MustCompile(`[-\s]+`)
+)
+
+// Slugify derives a slug from name with the rules Sleuth uses for the slugs of projects, environments, sources and
+// teams. Accents are folded to ASCII and other non-ASCII characters dropped, characters that are not letters,
+// digits, underscores, hyphens or whitespace are removed, and runs of whitespace and hyphens become a single hyphen.
+// Sleuth adds a numeric suffix when the slug is already taken, which is not predictable from the name alone.
+func Slugify(name string) string {
+	var ascii strings.Builder
+	for _, r := range norm.NFKD.String(name) {
+		if r <= unicode.MaxASCII {
+			ascii.WriteRune(r)
+		}
+	}
+	slug := nonSlugCharacters.ReplaceAllString(strings.ToLower(ascii.String()), "")
+	slug = slugSeparators.ReplaceAllString(slug, "-")
+	return strings.Trim(slug, "-_")
+}
@@ -0,0 +1,31 @@
+package gqlclient
+
+import (
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Production", expected: "production"},
+		{name: "Payments API", expected: "payments-api"},
+		{name: "  Payments  -  API  ", expected: "payments-api"},
+		{name: "terraform_acc_test", expected: "terraform_acc_test"},
+		{name: "_private_", expected: "private"},
+		{name: "RDS CPU (eu-west-1)", expected: "rds-cpu-eu-west-1"},
+		{name: "api.v2", expected: "apiv2"},
+		{name: "Café Zürich", expected: "cafe-zurich"},
+		{name: "日本", expected: ""},
+		{name: "---", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if slug := Slugify(tt.name); slug != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, slug)
+			}
+		})
+	}
+}
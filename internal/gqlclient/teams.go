@@ -3,7 +3,6 @@ package gqlclient
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/shurcooL/graphql"
 )
@@ -11,15 +10,16 @@ import (
 // GetTeam - Returns team
 func (c *Client) GetTeam(ctx context.Context, slug *string) (*Team, error) {
 	var query struct {
-		Team Team `graphql:"team(teamSlug: $teamSlug)"`
+		Team Team `graphql:"team(teamSlug: $teamSlug, orgSlug: $orgSlug)"`
 	}
 	variables := map[string]interface{}{
 		"teamSlug": graphql.ID(*slug),
+		"orgSlug":  graphql.ID(c.OrgSlug),
 	}
 	err := c.doQuery(ctx, &query, variables)
 	if err != nil {
-		if strings.HasSuffix(err.Error(), "not found") {
-			return nil, nil
+		if isNotFoundError(err, "team") {
+			return nil, ErrNotFound
 		}
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func (c *Client) CreateTeam(ctx context.Context, input CreateTeamMutationInput)
 	}
 
 	if len(m.CreateTeam.Errors) > 0 {
-		return nil, fmt.Errorf("%+v", m.CreateTeam.Errors)
+		return nil, newMutationError("creating team", m.CreateTeam.Errors)
 	}
 	return &m.CreateTeam.Team, nil
 }
@@ -67,7 +67,7 @@ func (c *Client) UpdateTeam(ctx context.Context, slug *string, input UpdateTeamM
 	}
 
 	if len(m.UpdateTeam.Errors) > 0 {
-		return nil, fmt.Errorf("%+v", m.UpdateTeam.Errors)
+		return nil, newMutationError("updating team", m.UpdateTeam.Errors)
 	}
 	return &m.UpdateTeam.Team, nil
 }
@@ -112,7 +112,7 @@ func (c *Client) AddTeamMembers(ctx context.Context, input AddTeamMembersMutatio
 		return err
 	}
 	if !m.AddTeamMembers.Success {
-		return fmt.Errorf("errors adding team members: %+v", m.AddTeamMembers.Errors)
+		return newMutationError("adding team members", m.AddTeamMembers.Errors)
 	}
 	return nil
 }
@@ -134,7 +134,7 @@ func (c *Client) RemoveTeamMembers(ctx context.Context, input RemoveTeamMembersM
 		return err
 	}
 	if !m.RemoveTeamMembers.Success {
-		return fmt.Errorf("errors removing team members: %+v", m.RemoveTeamMembers.Errors)
+		return newMutationError("removing team members", m.RemoveTeamMembers.Errors)
 	}
 	return nil
 }
@@ -0,0 +1,147 @@
+package mockserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+func (p *project) findCodeChangeSource(slug string) *gqlclient.CodeChangeSource {
+	for idx := range p.codeChangeSources {
+		if p.codeChangeSources[idx].Slug == slug {
+			return &p.codeChangeSources[idx]
+		}
+	}
+	return nil
+}
+
+func (s *Server) createCodeChangeSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.CreateCodeChangeSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("changeSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.MutableCodeChangeSource == nil || input.Name == "" {
+		return payload("changeSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+	if days := input.InitialHistoryDays; days != nil && (*days < 1 || !input.InitializeChanges) {
+		return payload("changeSource", nil, fieldErrors("initialHistoryDays", "Requires initializeChanges and at least one day.")), nil
+	}
+
+	ccs := gqlclient.CodeChangeSource{
+		Slug: uniqueSlug(input.Name, func(slug string) bool { return proj.findCodeChangeSource(slug) != nil }),
+	}
+	if errors := proj.applyCodeChangeSource(&ccs, input.MutableCodeChangeSource); len(errors) > 0 {
+		return payload("changeSource", nil, errors), nil
+	}
+	proj.codeChangeSources = append(proj.codeChangeSources, ccs)
+	return payload("changeSource", toObject(ccs, "CodeChangeSource"), nil), nil
+}
+
+func (s *Server) updateCodeChangeSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.UpdateCodeChangeSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("changeSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	ccs := proj.findCodeChangeSource(input.Slug)
+	if ccs == nil {
+		return payload("changeSource", nil, fieldErrors("slug", "Change source not found")), nil
+	}
+	if input.MutableCodeChangeSource == nil || input.Name == "" {
+		return payload("changeSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	updated := *ccs
+	if errors := proj.applyCodeChangeSource(&updated, input.MutableCodeChangeSource); len(errors) > 0 {
+		return payload("changeSource", nil, errors), nil
+	}
+	*ccs = updated
+	return payload("changeSource", toObject(*ccs, "CodeChangeSource"), nil), nil
+}
+
+func (s *Server) deleteChangeSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.DeleteChangeSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return success(false), nil
+	}
+
+	for idx, ccs := range proj.codeChangeSources {
+		if ccs.Slug == input.Slug {
+			proj.codeChangeSources = append(proj.codeChangeSources[:idx], proj.codeChangeSources[idx+1:]...)
+			return success(true), nil
+		}
+	}
+	return success(false), nil
+}
+
+// applyCodeChangeSource updates ccs from a mutation input. Mappings must refer to environments of the project.
+func (p *project) applyCodeChangeSource(ccs *gqlclient.CodeChangeSource, input *gqlclient.MutableCodeChangeSource) gqlclient.ErrorsType {
+	var errors gqlclient.ErrorsType
+
+	environmentMappings := []gqlclient.BranchMapping{}
+	for idx, em := range input.EnvironmentMappings {
+		if p.findEnvironment(em.EnvironmentSlug) == nil {
+			errors = append(errors, fieldErrors(
+				fmt.Sprintf("environmentMappings.%d.environmentSlug", idx),
+				fmt.Sprintf("Environment %s not found", em.EnvironmentSlug),
+			)...)
+			continue
+		}
+		environmentMappings = append(environmentMappings, em)
+	}
+
+	buildMappings := []gqlclient.DeployTrackingBuildMapping{}
+	for idx, bm := range input.BuildMappings {
+		env := p.findEnvironment(bm.EnvironmentSlug)
+		if env == nil {
+			errors = append(errors, fieldErrors(
+				fmt.Sprintf("buildMappings.%d.environmentSlug", idx),
+				fmt.Sprintf("Environment %s not found", bm.EnvironmentSlug),
+			)...)
+			continue
+		}
+		// a build project can be given by name, Sleuth resolves it to the key of the project with that name
+		projectKey := valueOrDefault(bm.BuildProjectKey, bm.BuildProjectName)
+		buildMappings = append(buildMappings, gqlclient.DeployTrackingBuildMapping{
+			Environment:              *env,
+			Provider:                 bm.Provider,
+			IntegrationSlug:          bm.IntegrationSlug,
+			BuildName:                bm.BuildName,
+			JobName:                  bm.JobName,
+			BuildProjectKey:          projectKey,
+			BuildProjectName:         bm.BuildProjectName,
+			MatchBranchToEnvironment: bm.MatchBranchToEnvironment,
+			IsCustom:                 bm.IsCustom,
+		})
+	}
+	if len(errors) > 0 {
+		return errors
+	}
+
+	ccs.Name = input.Name
+	ccs.Repository = gqlclient.Repository{RepositoryBase: input.Repository.RepositoryBase}
+	if input.Repository.IntegrationSlug != "" {
+		ccs.Repository.IntegrationAuth = &gqlclient.IntegrationAuth{Slug: input.Repository.IntegrationSlug}
+	}
+	ccs.DeployTrackingType = strings.ToLower(input.DeployTrackingType)
+	ccs.CollectImpact = input.CollectImpact
+	ccs.PathPrefix = input.PathPrefix
+	ccs.NotifyInSlack = input.NotifyInSlack
+	ccs.IncludeInDashboard = input.IncludeInDashboard
+	ccs.AutoTrackingDelay = input.AutoTrackingDelay
+	ccs.EnvironmentMappings = environmentMappings
+	ccs.DeployTrackingBuildMappings = buildMappings
+	return nil
+}
@@ -0,0 +1,97 @@
+package mockserver
+
+import (
+	"strings"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+const defaultEnvironmentColor = "#cecece"
+
+func (p *project) findEnvironment(slug string) *gqlclient.Environment {
+	for idx := range p.environments {
+		if p.environments[idx].Slug == slug {
+			return &p.environments[idx]
+		}
+	}
+	return nil
+}
+
+// findEnvironmentByName matches names case-insensitively, like Sleuth does for incident impact sources
+func (p *project) findEnvironmentByName(name string) *gqlclient.Environment {
+	for idx := range p.environments {
+		if strings.EqualFold(p.environments[idx].Name, name) {
+			return &p.environments[idx]
+		}
+	}
+	return nil
+}
+
+func (s *Server) createEnvironment(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.CreateEnvironmentMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("environment", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.MutableEnvironment == nil || input.Name == "" {
+		return payload("environment", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	env := gqlclient.Environment{
+		Slug:        uniqueSlug(input.Name, func(slug string) bool { return proj.findEnvironment(slug) != nil }),
+		Name:        input.Name,
+		Description: input.Description,
+		Color:       valueOrDefault(input.Color, defaultEnvironmentColor),
+	}
+	proj.environments = append(proj.environments, env)
+	return payload("environment", toObject(env, "Environment"), nil), nil
+}
+
+func (s *Server) updateEnvironment(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.UpdateEnvironmentMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("environment", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	env := proj.findEnvironment(input.Slug)
+	if env == nil {
+		return payload("environment", nil, fieldErrors("slug", "Environment not found")), nil
+	}
+	if input.MutableEnvironment == nil || input.Name == "" {
+		return payload("environment", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	// the slug is kept when an environment is renamed
+	env.Name = input.Name
+	env.Description = input.Description
+	env.Color = valueOrDefault(input.Color, env.Color)
+	return payload("environment", toObject(*env, "Environment"), nil), nil
+}
+
+func (s *Server) deleteEnvironment(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.DeleteEnvironmentMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return object{"success": false, "errors": toObjects(fieldErrors("projectSlug", err.Error()))}, nil
+	}
+	if input.Slug == defaultEnvironmentSlug {
+		return object{"success": false, "errors": toObjects(fieldErrors("slug", "You can not delete your default environment"))}, nil
+	}
+
+	for idx, env := range proj.environments {
+		if env.Slug == input.Slug {
+			proj.environments = append(proj.environments[:idx], proj.environments[idx+1:]...)
+			return success(true), nil
+		}
+	}
+	return object{"success": false, "errors": toObjects(fieldErrors("slug", "Environment not found"))}, nil
+}
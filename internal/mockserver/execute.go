@@ -0,0 +1,171 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// object is a resolved GraphQL object. Values are scalars, nested objects, lists or a fieldResolver for fields
+// that take arguments. The `__typename` key is matched against inline fragment type conditions.
+type object = map[string]interface{}
+
+// fieldResolver resolves a field from its arguments
+type fieldResolver func(args map[string]interface{}) (interface{}, error)
+
+const typenameKey = "__typename"
+
+// execute resolves the selections of op against root and returns the `data` of the response
+func execute(root object, op *operation, variables map[string]interface{}) (interface{}, error) {
+	return selectFields(root, op.selections, variables)
+}
+
+// selectFields returns the part of v that was selected. Fields missing in v are returned as null.
+func selectFields(v interface{}, selections []selection, variables map[string]interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []object:
+		list := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			selected, err := selectFields(item, selections, variables)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, selected)
+		}
+		return list, nil
+	case []interface{}:
+		list := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			selected, err := selectFields(item, selections, variables)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, selected)
+		}
+		return list, nil
+	case object:
+		result := object{}
+		if err := selectObjectFields(v, selections, variables, result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("can not select fields of %T", v)
+}
+
+func selectObjectFields(obj object, selections []selection, variables map[string]interface{}, result object) error {
+	for _, sel := range selections {
+		if sel.fragment {
+			if sel.typeCondition == "" || sel.typeCondition == obj[typenameKey] {
+				if err := selectObjectFields(obj, sel.selections, variables, result); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		field := obj[sel.name]
+		if resolve, ok := field.(fieldResolver); ok {
+			args, err := resolveArguments(sel.arguments, variables)
+			if err != nil {
+				return err
+			}
+			if field, err = resolve(args); err != nil {
+				return err
+			}
+		}
+
+		if len(sel.selections) == 0 {
+			result[sel.responseKey()] = field
+			continue
+		}
+		selected, err := selectFields(field, sel.selections, variables)
+		if err != nil {
+			return fmt.Errorf("%s: %w", sel.name, err)
+		}
+		result[sel.responseKey()] = selected
+	}
+	return nil
+}
+
+func resolveArguments(arguments map[string]value, variables map[string]interface{}) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	for name, arg := range arguments {
+		resolved, err := resolveValue(arg, variables)
+		if err != nil {
+			return nil, err
+		}
+		args[name] = resolved
+	}
+	return args, nil
+}
+
+func resolveValue(v value, variables map[string]interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case variableRef:
+		resolved, found := variables[string(v)]
+		if !found {
+			return nil, fmt.Errorf("variable $%s is not defined", v)
+		}
+		return resolved, nil
+	case []value:
+		list := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			resolved, err := resolveValue(item, variables)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, resolved)
+		}
+		return list, nil
+	case map[string]value:
+		obj := map[string]interface{}{}
+		for name, item := range v {
+			resolved, err := resolveValue(item, variables)
+			if err != nil {
+				return nil, err
+			}
+			obj[name] = resolved
+		}
+		return obj, nil
+	}
+	return v, nil
+}
+
+// toObject converts a gqlclient model to an object using its JSON field names, which match the GraphQL ones
+func toObject(v interface{}, typename string) object {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	obj := object{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		panic(err)
+	}
+	if typename != "" {
+		obj[typenameKey] = typename
+	}
+	return obj
+}
+
+// decodeArgument decodes an argument, usually a mutation input, into a gqlclient input type
+func decodeArgument(args map[string]interface{}, name string, v interface{}) error {
+	data, err := json.Marshal(args[name])
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func stringArgument(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+func intArgument(args map[string]interface{}, name string, fallback int) int {
+	if f, ok := args[name].(float64); ok {
+		return int(f)
+	}
+	return fallback
+}
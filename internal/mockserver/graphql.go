@@ -0,0 +1,371 @@
+package mockserver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The fake only has to understand the documents gqlclient sends: a single query or mutation with variable
+// definitions, nested fields with arguments and inline fragments. Fragment definitions and directives are not
+// supported.
+
+type operation struct {
+	kind       string
+	selections []selection
+}
+
+type selection struct {
+	alias     string
+	name      string
+	arguments map[string]value
+	// typeCondition is set for inline fragments, which only have selections
+	typeCondition string
+	selections    []selection
+	fragment      bool
+}
+
+func (s selection) responseKey() string {
+	if s.alias != "" {
+		return s.alias
+	}
+	return s.name
+}
+
+// value is an argument value that is resolved against the request variables
+type value interface{}
+
+type variableRef string
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+type parser struct {
+	src string
+	pos int
+	tok token
+}
+
+func parseOperation(src string) (*operation, error) {
+	p := &parser{src: src}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	op := &operation{kind: "query"}
+	if p.tok.kind == tokenName {
+		if p.tok.value != "query" && p.tok.value != "mutation" {
+			return nil, fmt.Errorf("unsupported operation %q", p.tok.value)
+		}
+		op.kind = p.tok.value
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind == tokenName {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+		if p.peek("(") {
+			if err := p.skipVariableDefinitions(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q after operation", p.tok.value)
+	}
+	op.selections = selections
+	return op, nil
+}
+
+func (p *parser) peek(punctuator string) bool {
+	return p.tok.kind == tokenPunctuator && p.tok.value == punctuator
+}
+
+func (p *parser) expect(punctuator string) error {
+	if !p.peek(punctuator) {
+		return fmt.Errorf("expected %q, got %q", punctuator, p.tok.value)
+	}
+	return p.next()
+}
+
+func (p *parser) expectName() (string, error) {
+	if p.tok.kind != tokenName {
+		return "", fmt.Errorf("expected a name, got %q", p.tok.value)
+	}
+	name := p.tok.value
+	return name, p.next()
+}
+
+// skipVariableDefinitions skips `($name: Type!, ...)`, variables are not type checked
+func (p *parser) skipVariableDefinitions() error {
+	depth := 0
+	for {
+		if p.tok.kind == tokenEOF {
+			return fmt.Errorf("unterminated variable definitions")
+		}
+		if p.peek("(") {
+			depth++
+		} else if p.peek(")") {
+			depth--
+			if depth == 0 {
+				return p.next()
+			}
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) parseSelectionSet() ([]selection, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var selections []selection
+	for !p.peek("}") {
+		if p.tok.kind == tokenEOF {
+			return nil, fmt.Errorf("unterminated selection set")
+		}
+		sel, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, sel)
+	}
+	return selections, p.next()
+}
+
+func (p *parser) parseSelection() (selection, error) {
+	if p.peek("...") {
+		if err := p.next(); err != nil {
+			return selection{}, err
+		}
+		sel := selection{fragment: true}
+		if p.tok.kind == tokenName && p.tok.value == "on" {
+			if err := p.next(); err != nil {
+				return selection{}, err
+			}
+			typeCondition, err := p.expectName()
+			if err != nil {
+				return selection{}, err
+			}
+			sel.typeCondition = typeCondition
+		}
+		selections, err := p.parseSelectionSet()
+		sel.selections = selections
+		return sel, err
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return selection{}, err
+	}
+	sel := selection{name: name}
+	if p.peek(":") {
+		if err := p.next(); err != nil {
+			return selection{}, err
+		}
+		sel.alias = name
+		if sel.name, err = p.expectName(); err != nil {
+			return selection{}, err
+		}
+	}
+	if p.peek("(") {
+		if sel.arguments, err = p.parseArguments(); err != nil {
+			return selection{}, err
+		}
+	}
+	if p.peek("{") {
+		if sel.selections, err = p.parseSelectionSet(); err != nil {
+			return selection{}, err
+		}
+	}
+	return sel, nil
+}
+
+func (p *parser) parseArguments() (map[string]value, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	arguments := map[string]value{}
+	for !p.peek(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if arguments[name], err = p.parseValue(); err != nil {
+			return nil, err
+		}
+	}
+	return arguments, p.next()
+}
+
+func (p *parser) parseValue() (value, error) {
+	tok := p.tok
+	switch {
+	case tok.kind == tokenPunctuator && tok.value == "$":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		name, err := p.expectName()
+		return variableRef(name), err
+	case tok.kind == tokenPunctuator && tok.value == "[":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		list := []value{}
+		for !p.peek("]") {
+			item, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		return list, p.next()
+	case tok.kind == tokenPunctuator && tok.value == "{":
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		object := map[string]value{}
+		for !p.peek("}") {
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			if object[name], err = p.parseValue(); err != nil {
+				return nil, err
+			}
+		}
+		return object, p.next()
+	case tok.kind == tokenInt:
+		i, err := strconv.ParseInt(tok.value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return float64(i), p.next()
+	case tok.kind == tokenFloat:
+		f, err := strconv.ParseFloat(tok.value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return f, p.next()
+	case tok.kind == tokenString:
+		return tok.value, p.next()
+	case tok.kind == tokenName:
+		switch tok.value {
+		case "true":
+			return true, p.next()
+		case "false":
+			return false, p.next()
+		case "null":
+			return nil, p.next()
+		}
+		// enum values are passed on as strings, like they are in variables
+		return tok.value, p.next()
+	}
+	return nil, fmt.Errorf("unexpected %q in value", tok.value)
+}
+
+func (p *parser) next() error {
+	// commas are insignificant in GraphQL, like whitespace
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '#' {
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+			continue
+		}
+		if c != ' ' && c != '\t' && c != '\n' && c != '\r' && c != ',' {
+			break
+		}
+		p.pos++
+	}
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokenEOF}
+		return nil
+	}
+
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.tok = token{kind: tokenPunctuator, value: "..."}
+	case strings.ContainsRune("!$():=@[]{}|", rune(c)):
+		p.pos++
+		p.tok = token{kind: tokenPunctuator, value: string(c)}
+	case isNameStart(c):
+		for p.pos < len(p.src) && (isNameStart(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		p.tok = token{kind: tokenName, value: p.src[start:p.pos]}
+	case c == '-' || isDigit(c):
+		kind := tokenInt
+		p.pos++
+		for p.pos < len(p.src) {
+			c := p.src[p.pos]
+			if c == '.' || c == 'e' || c == 'E' || c == '+' || (c == '-' && kind == tokenFloat) {
+				kind = tokenFloat
+			} else if !isDigit(c) {
+				break
+			}
+			p.pos++
+		}
+		p.tok = token{kind: kind, value: p.src[start:p.pos]}
+	case c == '"':
+		p.pos++
+		for p.pos < len(p.src) && p.src[p.pos] != '"' {
+			if p.src[p.pos] == '\\' {
+				p.pos++
+			}
+			p.pos++
+		}
+		if p.pos >= len(p.src) {
+			return fmt.Errorf("unterminated string")
+		}
+		p.pos++
+		s, err := strconv.Unquote(p.src[start:p.pos])
+		if err != nil {
+			return fmt.Errorf("invalid string %s: %w", p.src[start:p.pos], err)
+		}
+		p.tok = token{kind: tokenString, value: s}
+	default:
+		return fmt.Errorf("unexpected character %q", c)
+	}
+	return nil
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,339 @@
+package mockserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+type incidentImpactSource struct {
+	gqlclient.IncidentImpactSource
+
+	// providerData is the provider specific configuration, resolved as the ProviderData union
+	providerData object
+}
+
+func (iis incidentImpactSource) toObject() object {
+	obj := toObject(iis.IncidentImpactSource, "IncidentImpactSource")
+	obj["type"] = "INCIDENT"
+	obj["providerData"] = iis.providerData
+	return obj
+}
+
+func (p *project) impactSourceSlugTaken(slug string) bool {
+	for _, eis := range p.errorImpactSources {
+		if eis.Slug == slug {
+			return true
+		}
+	}
+	for _, mis := range p.metricImpactSources {
+		if mis.Slug == slug {
+			return true
+		}
+	}
+	for _, iis := range p.incidentImpactSources {
+		if iis.Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
+func environmentNotFound(field, slug string) gqlclient.ErrorsType {
+	return fieldErrors(field, fmt.Sprintf("Environment %s not found", slug))
+}
+
+func (s *Server) createErrorImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.CreateErrorImpactSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("impactSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.MutableErrorImpactSource == nil || input.Name == "" {
+		return payload("impactSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	eis := gqlclient.ErrorImpactSource{Slug: uniqueSlug(input.Name, proj.impactSourceSlugTaken)}
+	if errors := proj.applyErrorImpactSource(&eis, input.MutableErrorImpactSource); len(errors) > 0 {
+		return payload("impactSource", nil, errors), nil
+	}
+	proj.errorImpactSources = append(proj.errorImpactSources, eis)
+	return payload("impactSource", toObject(eis, "ErrorImpactSource"), nil), nil
+}
+
+func (s *Server) updateErrorImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.UpdateErrorImpactSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("impactSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.MutableErrorImpactSource == nil || input.Name == "" {
+		return payload("impactSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	for idx := range proj.errorImpactSources {
+		eis := &proj.errorImpactSources[idx]
+		if eis.Slug == input.Slug {
+			if errors := proj.applyErrorImpactSource(eis, input.MutableErrorImpactSource); len(errors) > 0 {
+				return payload("impactSource", nil, errors), nil
+			}
+			return payload("impactSource", toObject(*eis, "ErrorImpactSource"), nil), nil
+		}
+	}
+	return payload("impactSource", nil, fieldErrors("slug", "Impact source not found")), nil
+}
+
+func (p *project) applyErrorImpactSource(eis *gqlclient.ErrorImpactSource, input *gqlclient.MutableErrorImpactSource) gqlclient.ErrorsType {
+	env := p.findEnvironment(input.EnvironmentSlug)
+	if env == nil {
+		return environmentNotFound("environment", input.EnvironmentSlug)
+	}
+
+	eis.Environment = *env
+	eis.Name = input.Name
+	eis.Provider = strings.ToUpper(input.Provider)
+	eis.ErrorOrgKey = input.ErrorOrgKey
+	eis.ErrorProjectKey = input.ErrorProjectKey
+	eis.ErrorEnvironment = input.ErrorEnvironment
+	eis.ManuallySetHealthThreshold = nil
+	if input.ManuallySetHealthThreshold != 0 {
+		threshold := input.ManuallySetHealthThreshold
+		eis.ManuallySetHealthThreshold = &threshold
+	}
+	eis.IntegrationAuthSlug = input.IntegrationSlug
+	return nil
+}
+
+func (s *Server) createMetricImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.CreateMetricImpactSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("impactSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.MutableMetricImpactSource == nil || input.Name == "" {
+		return payload("impactSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	mis := gqlclient.MetricImpactSource{Slug: uniqueSlug(input.Name, proj.impactSourceSlugTaken)}
+	if errors := proj.applyMetricImpactSource(&mis, input.MutableMetricImpactSource); len(errors) > 0 {
+		return payload("impactSource", nil, errors), nil
+	}
+	proj.metricImpactSources = append(proj.metricImpactSources, mis)
+	return payload("impactSource", toObject(mis, "MetricImpactSource"), nil), nil
+}
+
+func (s *Server) updateMetricImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.UpdateMetricImpactSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("impactSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.MutableMetricImpactSource == nil || input.Name == "" {
+		return payload("impactSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	for idx := range proj.metricImpactSources {
+		mis := &proj.metricImpactSources[idx]
+		if mis.Slug == input.Slug {
+			if errors := proj.applyMetricImpactSource(mis, input.MutableMetricImpactSource); len(errors) > 0 {
+				return payload("impactSource", nil, errors), nil
+			}
+			return payload("impactSource", toObject(*mis, "MetricImpactSource"), nil), nil
+		}
+	}
+	return payload("impactSource", nil, fieldErrors("slug", "Impact source not found")), nil
+}
+
+func (p *project) applyMetricImpactSource(mis *gqlclient.MetricImpactSource, input *gqlclient.MutableMetricImpactSource) gqlclient.ErrorsType {
+	env := p.findEnvironment(input.EnvironmentSlug)
+	if env == nil {
+		return environmentNotFound("environment", input.EnvironmentSlug)
+	}
+
+	mis.Environment = *env
+	mis.Name = input.Name
+	mis.Provider = strings.ToUpper(input.Provider)
+	mis.Query = input.Query
+	mis.IntegrationAuthSlug = input.IntegrationSlug
+	mis.LessIsBetter = input.LessIsBetter
+	mis.ManuallySetHealthThreshold = nil
+	if input.ManuallySetHealthThreshold != 0 {
+		threshold := input.ManuallySetHealthThreshold
+		mis.ManuallySetHealthThreshold = &threshold
+	}
+	return nil
+}
+
+func (s *Server) deleteImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.DeleteImpactSourceMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return success(false), nil
+	}
+	return success(proj.deleteImpactSource(input.Slug)), nil
+}
+
+func (p *project) deleteImpactSource(slug string) bool {
+	for idx, eis := range p.errorImpactSources {
+		if eis.Slug == slug {
+			p.errorImpactSources = append(p.errorImpactSources[:idx], p.errorImpactSources[idx+1:]...)
+			return true
+		}
+	}
+	for idx, mis := range p.metricImpactSources {
+		if mis.Slug == slug {
+			p.metricImpactSources = append(p.metricImpactSources[:idx], p.metricImpactSources[idx+1:]...)
+			return true
+		}
+	}
+	for idx, iis := range p.incidentImpactSources {
+		if iis.Slug == slug {
+			p.incidentImpactSources = append(p.incidentImpactSources[:idx], p.incidentImpactSources[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createIncidentImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.IncidentImpactSourceInputType
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("impactSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.Name == "" {
+		return payload("impactSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	iis := incidentImpactSource{
+		IncidentImpactSource: gqlclient.IncidentImpactSource{Slug: uniqueSlug(input.Name, proj.impactSourceSlugTaken)},
+	}
+	if errors := proj.applyIncidentImpactSource(&iis, input); len(errors) > 0 {
+		return payload("impactSource", nil, errors), nil
+	}
+	proj.incidentImpactSources = append(proj.incidentImpactSources, iis)
+	return payload("impactSource", iis.toObject(), nil), nil
+}
+
+func (s *Server) updateIncidentImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.IncidentImpactSourceInputUpdateType
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return payload("impactSource", nil, fieldErrors("projectSlug", err.Error())), nil
+	}
+	if input.Name == "" {
+		return payload("impactSource", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	for idx := range proj.incidentImpactSources {
+		iis := &proj.incidentImpactSources[idx]
+		if iis.Slug == input.Slug {
+			if errors := proj.applyIncidentImpactSource(iis, input.IncidentImpactSourceInputType); len(errors) > 0 {
+				return payload("impactSource", nil, errors), nil
+			}
+			return payload("impactSource", iis.toObject(), nil), nil
+		}
+	}
+	return payload("impactSource", nil, fieldErrors("slug", "Impact source not found")), nil
+}
+
+func (s *Server) deleteIncidentImpactSource(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.IncidentImpactSourceDeleteInputType
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.ProjectSlug)
+	if err != nil {
+		return object{"success": false}, nil
+	}
+
+	for idx, iis := range proj.incidentImpactSources {
+		if iis.Slug == input.Slug {
+			proj.incidentImpactSources = append(proj.incidentImpactSources[:idx], proj.incidentImpactSources[idx+1:]...)
+			return object{"success": true}, nil
+		}
+	}
+	return object{"success": false}, nil
+}
+
+func (p *project) applyIncidentImpactSource(iis *incidentImpactSource, input gqlclient.IncidentImpactSourceInputType) gqlclient.ErrorsType {
+	env := p.findEnvironmentByName(input.EnvironmentName)
+	if env == nil {
+		return environmentNotFound("environmentName", input.EnvironmentName)
+	}
+
+	var typename, integrationSlug string
+	var data interface{}
+	switch strings.ToLower(input.Provider) {
+	case "pagerduty":
+		if input.PagerDutyInputType != nil {
+			typename, data = "PagerDutyProviderData", gqlclient.PagerDutyProviderData(*input.PagerDutyInputType)
+		}
+	case "datadog":
+		if input.DataDogInputType != nil {
+			typename, data, integrationSlug = "DataDogProviderData", input.DataDogInputType.DataDogProviderData, input.DataDogInputType.IntegrationSlug
+		}
+	case "jira":
+		if input.JiraInputType != nil {
+			typename, data, integrationSlug = "JiraProviderData", input.JiraInputType.JiraProviderData, input.JiraInputType.IntegrationSlug
+		}
+	case "blameless":
+		if input.BlamelessInputType != nil {
+			typename, data, integrationSlug = "BlamelessProviderData", input.BlamelessInputType.BlamelessProviderData, input.BlamelessInputType.IntegrationSlug
+		}
+	case "statuspage":
+		if input.StatuspageInputType != nil {
+			typename, data, integrationSlug = "StatuspageProviderData", input.StatuspageInputType.StatuspageProviderData, input.StatuspageInputType.IntegrationSlug
+		}
+	case "opsgenie":
+		if input.OpsGenieInputType != nil {
+			typename, data, integrationSlug = "OpsgenieProviderData", input.OpsGenieInputType.OpsGenieProviderData, input.OpsGenieInputType.IntegrationSlug
+		}
+	case "firehydrant":
+		if input.FireHydrantInputType != nil {
+			typename, data, integrationSlug = "FireHydrantProviderData", input.FireHydrantInputType.FireHydrantProviderData, input.FireHydrantInputType.IntegrationSlug
+		}
+	case "clubhouse":
+		if input.ClubhouseInputType != nil {
+			typename, data, integrationSlug = "ClubhouseProviderData", input.ClubhouseInputType.ClubhouseProviderData, input.ClubhouseInputType.IntegrationSlug
+		}
+	case "rootly":
+		if input.RootlyInputType != nil {
+			typename, data, integrationSlug = "RootlyProviderData", input.RootlyInputType.RootlyProviderData, input.RootlyInputType.IntegrationSlug
+		}
+	default:
+		return fieldErrors("provider", fmt.Sprintf("Unsupported provider %s", input.Provider))
+	}
+	if data == nil {
+		return fieldErrors("provider", fmt.Sprintf("Missing input for provider %s", input.Provider))
+	}
+
+	iis.Environment = *env
+	iis.Name = input.Name
+	iis.Provider = strings.ToUpper(input.Provider)
+	iis.IntegrationAuthSlug = integrationSlug
+	iis.providerData = toObject(data, typename)
+	return nil
+}
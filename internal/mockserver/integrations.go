@@ -0,0 +1,155 @@
+package mockserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// AddIntegration connects an integration to the organization. Like Sleuth, its slug is the lowercase provider followed
+// by the description label, if any, with spaces replaced by dashes.
+func (s *Server) AddIntegration(provider, label string) gqlclient.IntegrationAuthentication {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	integration := newIntegration(provider, label)
+	s.integrations = append(s.integrations, integration)
+	return integration
+}
+
+func newIntegration(provider, label string) gqlclient.IntegrationAuthentication {
+	slug := strings.ToLower(provider)
+	if label != "" {
+		slug += "-" + strings.ReplaceAll(label, " ", "-")
+	}
+	return gqlclient.IntegrationAuthentication{
+		Slug:     slug,
+		Name:     strings.TrimSpace(provider + " " + label),
+		Provider: strings.ToUpper(provider),
+		Label:    label,
+	}
+}
+
+func (s *Server) findIntegration(slug string) int {
+	for idx, integration := range s.integrations {
+		if integration.Slug == slug {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (s *Server) resolveIntegrationAuths(map[string]interface{}) (interface{}, error) {
+	objects := []object{}
+	for _, integration := range s.integrations {
+		objects = append(objects, toObject(integration, "IntegrationAuthentication"))
+	}
+	return objects, nil
+}
+
+func (s *Server) createIntegrationAuth(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.CreateIntegrationAuthMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	if errors := validateCredentials(input.Provider, input.IntegrationAuthCredentials); len(errors) > 0 {
+		return payload("integrationAuth", nil, errors), nil
+	}
+
+	integration := newIntegration(input.Provider, input.Label)
+	if s.findIntegration(integration.Slug) >= 0 {
+		return payload("integrationAuth", nil, fieldErrors("label", fmt.Sprintf("Integration %s already exists", integration.Slug))), nil
+	}
+	s.integrations = append(s.integrations, integration)
+	s.credentials[integration.Slug] = input.IntegrationAuthCredentials
+	return payload("integrationAuth", toObject(integration, "IntegrationAuthentication"), nil), nil
+}
+
+func (s *Server) updateIntegrationAuth(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.UpdateIntegrationAuthMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	idx := s.findIntegration(input.Slug)
+	if idx < 0 {
+		return payload("integrationAuth", nil, fieldErrors("slug", "Integration not found")), nil
+	}
+	integration := s.integrations[idx]
+	if errors := validateCredentials(integration.Provider, input.IntegrationAuthCredentials); len(errors) > 0 {
+		return payload("integrationAuth", nil, errors), nil
+	}
+
+	s.credentials[integration.Slug] = input.IntegrationAuthCredentials
+	return payload("integrationAuth", toObject(integration, "IntegrationAuthentication"), nil), nil
+}
+
+func (s *Server) deleteIntegrationAuth(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.DeleteIntegrationAuthMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	idx := s.findIntegration(input.Slug)
+	if idx < 0 {
+		return object{"success": false, "errors": toObjects(fieldErrors("slug", "Integration not found"))}, nil
+	}
+	s.integrations = append(s.integrations[:idx], s.integrations[idx+1:]...)
+	delete(s.credentials, input.Slug)
+	return success(true), nil
+}
+
+// validateCredentials returns errors unless credentials hold exactly the block of provider, with its required fields
+func validateCredentials(provider string, credentials gqlclient.IntegrationAuthCredentials) gqlclient.ErrorsType {
+	var blocks int
+	for _, set := range []bool{
+		credentials.Datadog != nil, credentials.PagerDuty != nil, credentials.Sentry != nil,
+		credentials.Jenkins != nil, credentials.CloudWatch != nil,
+	} {
+		if set {
+			blocks++
+		}
+	}
+
+	var field string
+	var required map[string]string
+	switch strings.ToUpper(provider) {
+	case "DATADOG":
+		field = "datadog"
+		if c := credentials.Datadog; c != nil {
+			required = map[string]string{"apiKey": c.APIKey, "appKey": c.AppKey}
+		}
+	case "PAGERDUTY":
+		field = "pagerDuty"
+		if c := credentials.PagerDuty; c != nil {
+			required = map[string]string{"apiKey": c.APIKey}
+		}
+	case "SENTRY":
+		field = "sentry"
+		if c := credentials.Sentry; c != nil {
+			required = map[string]string{"authToken": c.AuthToken, "organizationSlug": c.OrganizationSlug}
+		}
+	case "JENKINS":
+		field = "jenkins"
+		if c := credentials.Jenkins; c != nil {
+			required = map[string]string{"url": c.Url, "username": c.Username, "apiToken": c.APIToken}
+		}
+	case "CLOUDWATCH":
+		field = "cloudWatch"
+		if c := credentials.CloudWatch; c != nil {
+			required = map[string]string{"accessKeyId": c.AccessKeyID, "secretAccessKey": c.SecretAccessKey, "region": c.Region}
+		}
+	default:
+		return fieldErrors("provider", fmt.Sprintf("Integration provider %s cannot be connected through the API", provider))
+	}
+
+	if required == nil || blocks != 1 {
+		return fieldErrors(field, fmt.Sprintf("Exactly the %s credentials are required", field))
+	}
+	var errors gqlclient.ErrorsType
+	for name, value := range required {
+		if value == "" {
+			errors = append(errors, fieldErrors(field+"."+name, "This field is required.")...)
+		}
+	}
+	return errors
+}
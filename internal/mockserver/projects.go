@@ -0,0 +1,178 @@
+package mockserver
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+const defaultEnvironmentSlug = "production"
+
+type project struct {
+	gqlclient.Project
+
+	environments          []gqlclient.Environment
+	codeChangeSources     []gqlclient.CodeChangeSource
+	errorImpactSources    []gqlclient.ErrorImpactSource
+	metricImpactSources   []gqlclient.MetricImpactSource
+	incidentImpactSources []incidentImpactSource
+}
+
+func (s *Server) getProject(slug string) (*project, error) {
+	proj, found := s.projects[slug]
+	if !found {
+		return nil, fmt.Errorf("Project not found")
+	}
+	return proj, nil
+}
+
+func (p *project) toObject() object {
+	obj := toObject(p.Project, "Project")
+
+	var environments []object
+	for _, env := range p.environments {
+		environments = append(environments, toObject(env, "Environment"))
+	}
+	obj["environments"] = environments
+
+	var changeSources []object
+	for _, ccs := range p.codeChangeSources {
+		changeSource := toObject(ccs, "CodeChangeSource")
+		changeSource["type"] = "CODE"
+		changeSources = append(changeSources, changeSource)
+	}
+	obj["changeSources"] = changeSources
+
+	var impactSources []object
+	for _, eis := range p.errorImpactSources {
+		impactSource := toObject(eis, "ErrorImpactSource")
+		impactSource["type"] = "ERROR"
+		impactSources = append(impactSources, impactSource)
+	}
+	for _, mis := range p.metricImpactSources {
+		impactSource := toObject(mis, "MetricImpactSource")
+		impactSource["type"] = "METRIC"
+		impactSources = append(impactSources, impactSource)
+	}
+	for _, iis := range p.incidentImpactSources {
+		impactSources = append(impactSources, iis.toObject())
+	}
+	obj["impactSources"] = impactSources
+	return obj
+}
+
+func (s *Server) resolveProject(args map[string]interface{}) (interface{}, error) {
+	proj, err := s.getProject(stringArgument(args, "projectSlug"))
+	if err != nil {
+		return nil, err
+	}
+	return proj.toObject(), nil
+}
+
+func (s *Server) resolveProjects(args map[string]interface{}) (interface{}, error) {
+	if orgSlug := stringArgument(args, "orgSlug"); orgSlug != "" && orgSlug != s.org.Slug {
+		return nil, fmt.Errorf("Organization not found")
+	}
+	var slugs []string
+	for slug := range s.projects {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	page := intArgument(args, "page", 1)
+	pageSize := intArgument(args, "pageSize", 50)
+	start := (page - 1) * pageSize
+	if start > len(slugs) {
+		start = len(slugs)
+	}
+	end := start + pageSize
+	if end > len(slugs) {
+		end = len(slugs)
+	}
+
+	var objects []object
+	for _, slug := range slugs[start:end] {
+		objects = append(objects, toObject(s.projects[slug].Project, "Project"))
+	}
+	return object{"objects": objects, "hasNext": end < len(slugs)}, nil
+}
+
+func (s *Server) createProject(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.CreateProjectMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	if input.MutableProject == nil || input.Name == "" {
+		return payload("project", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	slug := uniqueSlug(input.Name, func(slug string) bool { return s.projects[slug] != nil })
+	proj := &project{
+		Project: gqlclient.Project{Slug: slug},
+		environments: []gqlclient.Environment{
+			{Slug: defaultEnvironmentSlug, Name: "Production", Color: "#279a38"},
+		},
+	}
+	proj.apply(input.MutableProject)
+	s.projects[slug] = proj
+	return payload("project", toObject(proj.Project, "Project"), nil), nil
+}
+
+func (s *Server) updateProject(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.UpdateProjectMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	proj, err := s.getProject(input.Slug)
+	if err != nil {
+		return payload("project", nil, fieldErrors("slug", err.Error())), nil
+	}
+	if input.MutableProject == nil || input.Name == "" {
+		return payload("project", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	proj.apply(input.MutableProject)
+	return payload("project", toObject(proj.Project, "Project"), nil), nil
+}
+
+func (s *Server) deleteProject(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.DeleteProjectMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	if s.projects[input.Slug] == nil {
+		return success(false), nil
+	}
+	delete(s.projects, input.Slug)
+	return success(true), nil
+}
+
+// apply updates the project from a mutation input, using Sleuth's defaults for missing values
+func (p *project) apply(input *gqlclient.MutableProject) {
+	p.Name = input.Name
+	p.Description = input.Description
+	p.IssueTrackerProvider = valueOrDefault(input.IssueTrackerProvider, "SOURCE_PROVIDER")
+	p.BuildProvider = valueOrDefault(input.BuildProvider, "NONE")
+	p.ChangeFailureRateBoundary = valueOrDefault(input.ChangeFailureRateBoundary, "UNHEALTHY")
+	p.ImpactSensitivity = valueOrDefault(input.ImpactSensitivity, "NORMAL")
+	p.FailureSensitivity = input.FailureSensitivity
+	if p.FailureSensitivity == 0 {
+		p.FailureSensitivity = 420
+	}
+	p.CltStartDefinition = valueOrDefault(input.CltStartDefinition, "COMMIT")
+	p.CltStartStates = nil
+	for _, state := range input.CltStartStates {
+		p.CltStartStates = append(p.CltStartStates, gqlclient.CLTStartStates{ID: strconv.Itoa(state)})
+	}
+	p.StrictIssueMatching = input.StrictIssueMatching
+	p.LabelNames = append([]string{}, input.Labels...)
+}
+
+func valueOrDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,202 @@
+// Package mockserver is an in-memory fake of the Sleuth GraphQL API. It implements the queries and mutations sent by
+// gqlclient so the provider can be tested without a Sleuth organization.
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// Server is a fake Sleuth API listening on a local address. Point the provider `baseurl` at URL.
+type Server struct {
+	*httptest.Server
+
+	apiKey string
+
+	mu       sync.Mutex
+	org      gqlclient.Organization
+	projects map[string]*project
+	teams    map[string]*team
+	users    []gqlclient.User
+	lastID   int
+
+	integrations []gqlclient.IntegrationAuthentication
+	// credentials of the integrations connected through the API, by slug
+	credentials map[string]gqlclient.IntegrationAuthCredentials
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+type graphQLResponse struct {
+	Data   interface{}    `json:"data"`
+	Errors []graphQLError `json:"errors,omitempty"`
+}
+
+// NewServer starts a fake API for the organization orgSlug that accepts requests authenticated with apiKey
+func NewServer(apiKey, orgSlug string) *Server {
+	s := &Server{
+		apiKey:   apiKey,
+		projects: map[string]*project{},
+		teams:    map[string]*team{},
+
+		credentials: map[string]gqlclient.IntegrationAuthCredentials{},
+	}
+	s.org = gqlclient.Organization{ID: s.nextID(), Slug: orgSlug, Name: orgSlug}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddUser adds a member to the organization, so it can be added to teams
+func (s *Server) AddUser(email string) gqlclient.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	username := strings.Split(email, "@")[0]
+	user := gqlclient.User{ID: s.nextID(), Username: username, Email: email, IsActive: true}
+	s.users = append(s.users, user)
+	return user
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/graphql" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if r.Header.Get("Authorization") != "apikey "+s.apiKey {
+		http.Error(w, "Invalid API key", http.StatusUnauthorized)
+		return
+	}
+
+	var req graphQLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var res graphQLResponse
+	op, err := parseOperation(req.Query)
+	if err == nil {
+		s.mu.Lock()
+		root := s.queryRoot()
+		if op.kind == "mutation" {
+			root = s.mutationRoot()
+		}
+		res.Data, err = execute(root, op, req.Variables)
+		s.mu.Unlock()
+	}
+	if err != nil {
+		res = graphQLResponse{Errors: []graphQLError{{Message: err.Error()}}}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) queryRoot() object {
+	return object{
+		"organization": fieldResolver(s.resolveOrganization),
+		"project":      fieldResolver(s.resolveProject),
+		"projects":     fieldResolver(s.resolveProjects),
+		"team":         fieldResolver(s.resolveTeam),
+	}
+}
+
+func (s *Server) mutationRoot() object {
+	return object{
+		"createProject":              fieldResolver(s.createProject),
+		"updateProject":              fieldResolver(s.updateProject),
+		"deleteProject":              fieldResolver(s.deleteProject),
+		"createEnvironment":          fieldResolver(s.createEnvironment),
+		"updateEnvironment":          fieldResolver(s.updateEnvironment),
+		"deleteEnvironment":          fieldResolver(s.deleteEnvironment),
+		"createCodeChangeSource":     fieldResolver(s.createCodeChangeSource),
+		"updateCodeChangeSource":     fieldResolver(s.updateCodeChangeSource),
+		"deleteChangeSource":         fieldResolver(s.deleteChangeSource),
+		"createErrorImpactSource":    fieldResolver(s.createErrorImpactSource),
+		"updateErrorImpactSource":    fieldResolver(s.updateErrorImpactSource),
+		"createMetricImpactSource":   fieldResolver(s.createMetricImpactSource),
+		"updateMetricImpactSource":   fieldResolver(s.updateMetricImpactSource),
+		"deleteImpactSource":         fieldResolver(s.deleteImpactSource),
+		"createIncidentImpactSource": fieldResolver(s.createIncidentImpactSource),
+		"updateIncidentImpactSource": fieldResolver(s.updateIncidentImpactSource),
+		"deleteIncidentImpactSource": fieldResolver(s.deleteIncidentImpactSource),
+		"createTeam":                 fieldResolver(s.createTeam),
+		"updateTeam":                 fieldResolver(s.updateTeam),
+		"deleteTeam":                 fieldResolver(s.deleteTeam),
+		"addTeamMembers":             fieldResolver(s.addTeamMembers),
+		"removeTeamMembers":          fieldResolver(s.removeTeamMembers),
+		"createIntegrationAuth":      fieldResolver(s.createIntegrationAuth),
+		"updateIntegrationAuth":      fieldResolver(s.updateIntegrationAuth),
+		"deleteIntegrationAuth":      fieldResolver(s.deleteIntegrationAuth),
+	}
+}
+
+func (s *Server) nextID() string {
+	s.lastID++
+	return strconv.Itoa(s.lastID)
+}
+
+// uniqueSlug derives a slug from name the way Sleuth does, adding a numeric suffix if taken is true for it
+func uniqueSlug(name string, taken func(slug string) bool) string {
+	base := gqlclient.Slugify(name)
+	slug := base
+	for i := 2; taken(slug); i++ {
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+	return slug
+}
+
+// payload is the result of a mutation, with the errors it was rejected with
+func payload(key string, value interface{}, errors gqlclient.ErrorsType) object {
+	if errors == nil {
+		errors = gqlclient.ErrorsType{}
+	}
+	return object{key: value, "errors": toObjects(errors)}
+}
+
+func fieldErrors(field string, messages ...string) gqlclient.ErrorsType {
+	return gqlclient.ErrorsType{{Field: field, Messages: messages}}
+}
+
+func success(ok bool) object {
+	return object{"success": ok, "errors": []object{}}
+}
+
+func toObjects(errors gqlclient.ErrorsType) []object {
+	objects := make([]object, 0, len(errors))
+	for _, fieldError := range errors {
+		objects = append(objects, toObject(fieldError, ""))
+	}
+	return objects
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -0,0 +1,329 @@
+package mockserver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+func newTestClient(t *testing.T) (*Server, *gqlclient.Client) {
+	t.Helper()
+	server := NewServer("test-key", "acme")
+	t.Cleanup(server.Close)
+
+	apiKey := "test-key"
+	c, err := gqlclient.NewClient(&server.URL, &apiKey, "acme", "test", 10*time.Second, gqlclient.RetryPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, c
+}
+
+func TestProjectAndEnvironmentLifecycle(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	proj, err := c.CreateProject(ctx, gqlclient.CreateProjectMutationInput{
+		MutableProject: &gqlclient.MutableProject{Name: "Payments API", Labels: []string{"team:payments"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proj.Slug != "payments-api" || proj.BuildProvider != "NONE" || proj.FailureSensitivity != 420 {
+		t.Errorf("unexpected project %+v", proj)
+	}
+
+	env, err := c.CreateEnvironment(ctx, gqlclient.CreateEnvironmentMutationInput{
+		ProjectSlug:        proj.Slug,
+		MutableEnvironment: &gqlclient.MutableEnvironment{Name: "Staging"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Slug != "staging" || env.Color != defaultEnvironmentColor {
+		t.Errorf("unexpected environment %+v", env)
+	}
+
+	read, err := c.GetEnvironment(ctx, &proj.Slug, &env.Slug)
+	if err != nil || read.Name != "Staging" {
+		t.Errorf("unexpected environment %+v, %v", read, err)
+	}
+	if err := c.DeleteEnvironment(ctx, &proj.Slug, &env.Slug); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetEnvironment(ctx, &proj.Slug, &env.Slug); !errors.Is(err, gqlclient.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a deleted environment, got %v", err)
+	}
+	// the default environment can not be deleted, the client ignores that error
+	production := defaultEnvironmentSlug
+	if err := c.DeleteEnvironment(ctx, &proj.Slug, &production); err != nil {
+		t.Errorf("unexpected error deleting the default environment %v", err)
+	}
+
+	projects, err := c.GetProjects(ctx)
+	if err != nil || len(projects) != 1 || projects[0].LabelNames[0] != "team:payments" {
+		t.Errorf("unexpected projects %+v, %v", projects, err)
+	}
+
+	if err := c.DeleteProject(ctx, &proj.Slug); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetProject(ctx, &proj.Slug); !errors.Is(err, gqlclient.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a deleted project, got %v", err)
+	}
+}
+
+func TestChangeAndImpactSources(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	proj, err := c.CreateProject(ctx, gqlclient.CreateProjectMutationInput{
+		MutableProject: &gqlclient.MutableProject{Name: "Payments"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ccs, err := c.CreateCodeChangeSource(ctx, gqlclient.CreateCodeChangeSourceMutationInput{
+		ProjectSlug: proj.Slug,
+		MutableCodeChangeSource: &gqlclient.MutableCodeChangeSource{
+			Name:                "Payments repo",
+			Repository:          gqlclient.MutableRepository{RepositoryBase: gqlclient.RepositoryBase{Owner: "acme", Name: "payments", Provider: "GITHUB"}},
+			DeployTrackingType:  "build",
+			EnvironmentMappings: []gqlclient.BranchMapping{{EnvironmentSlug: "production", Branch: "main"}},
+			BuildMappings: []gqlclient.BuildMapping{{
+				EnvironmentSlug:  "production",
+				Provider:         "GITHUB",
+				BuildName:        "deploy",
+				BuildProjectName: "acme/payments",
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := c.GetCodeChangeSource(ctx, &proj.Slug, &ccs.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.DeployTrackingType != "build" || read.DeployTrackingBuildMappings[0].BuildProjectKey != "acme/payments" {
+		t.Errorf("unexpected change source %+v", read)
+	}
+
+	_, err = c.CreateErrorImpactSource(ctx, gqlclient.CreateErrorImpactSourceMutationInput{
+		ProjectSlug: proj.Slug,
+		MutableErrorImpactSource: &gqlclient.MutableErrorImpactSource{
+			EnvironmentSlug: "missing", Name: "Sentry", Provider: "SENTRY",
+		},
+	})
+	var mutationErr *gqlclient.MutationError
+	if !errors.As(err, &mutationErr) || mutationErr.Errors[0].Field != "environment" {
+		t.Errorf("expected a field error for a missing environment, got %v", err)
+	}
+
+	mis, err := c.CreateMetricImpactSource(ctx, gqlclient.CreateMetricImpactSourceMutationInput{
+		ProjectSlug: proj.Slug,
+		MutableMetricImpactSource: &gqlclient.MutableMetricImpactSource{
+			EnvironmentSlug: "production", Name: "Latency", Provider: "DATADOG", Query: "avg:latency", ManuallySetHealthThreshold: 2.5,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mis.ManuallySetHealthThreshold == nil || *mis.ManuallySetHealthThreshold != 2.5 {
+		t.Errorf("unexpected metric impact source %+v", mis)
+	}
+
+	iis, err := c.CreateIncidentImpactSource(ctx, gqlclient.IncidentImpactSourceInputType{
+		ProjectSlug:     proj.Slug,
+		EnvironmentName: "Production",
+		Name:            "Jira incidents",
+		Provider:        "jira",
+		JiraInputType:   &gqlclient.JiraInputType{JiraProviderData: gqlclient.JiraProviderData{RemoteJql: "type = Incident"}, IntegrationSlug: "jira"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	readIncident, err := c.GetIncidentImpactSource(ctx, proj.Slug, iis.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if readIncident.ProviderData.JiraProviderData.RemoteJql != "type = Incident" || readIncident.IntegrationAuthSlug != "jira" {
+		t.Errorf("unexpected incident impact source %+v", readIncident)
+	}
+	// the metric impact source is not returned as an error impact source
+	if _, err := c.GetErrorImpactSource(ctx, &proj.Slug, &mis.Slug); !errors.Is(err, gqlclient.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	if err := c.DeleteImpactSource(ctx, &proj.Slug, &mis.Slug); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteChangeSource(ctx, &proj.Slug, &ccs.Slug); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConcurrentCodeChangeSourceMappingUpdates(t *testing.T) {
+	_, c := newTestClient(t)
+	ctx := context.Background()
+
+	proj, err := c.CreateProject(ctx, gqlclient.CreateProjectMutationInput{
+		MutableProject: &gqlclient.MutableProject{Name: "Payments"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	environments := []string{"staging", "qa", "demo", "canary"}
+	for _, name := range environments {
+		if _, err := c.CreateEnvironment(ctx, gqlclient.CreateEnvironmentMutationInput{
+			ProjectSlug:        proj.Slug,
+			MutableEnvironment: &gqlclient.MutableEnvironment{Name: name},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ccs, err := c.CreateCodeChangeSource(ctx, gqlclient.CreateCodeChangeSourceMutationInput{
+		ProjectSlug: proj.Slug,
+		MutableCodeChangeSource: &gqlclient.MutableCodeChangeSource{
+			Name:                "Payments repo",
+			Repository:          gqlclient.MutableRepository{RepositoryBase: gqlclient.RepositoryBase{Owner: "acme", Name: "payments", Provider: "GITHUB"}},
+			DeployTrackingType:  "manual",
+			EnvironmentMappings: []gqlclient.BranchMapping{{EnvironmentSlug: "production", Branch: "main"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each update adds its own mapping, none of them may be lost to another update
+	var wg sync.WaitGroup
+	errs := make(chan error, len(environments))
+	for _, environmentSlug := range environments {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.UpdateCodeChangeSourceMappings(ctx, proj.Slug, ccs.Slug, func(input *gqlclient.MutableCodeChangeSource) error {
+				input.EnvironmentMappings = append(input.EnvironmentMappings, gqlclient.BranchMapping{EnvironmentSlug: environmentSlug, Branch: environmentSlug})
+				return nil
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	read, err := c.GetCodeChangeSource(ctx, &proj.Slug, &ccs.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read.EnvironmentMappings) != len(environments)+1 {
+		t.Errorf("expected %d environment mappings, got %+v", len(environments)+1, read.EnvironmentMappings)
+	}
+
+	missing := "missing"
+	if _, err := c.UpdateCodeChangeSourceMappings(ctx, proj.Slug, missing, func(*gqlclient.MutableCodeChangeSource) error { return nil }); !errors.Is(err, gqlclient.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a missing code change source, got %v", err)
+	}
+}
+
+func TestTeams(t *testing.T) {
+	server, c := newTestClient(t)
+	ctx := context.Background()
+	user := server.AddUser("jane@acme.io")
+
+	parent, err := c.CreateTeam(ctx, gqlclient.CreateTeamMutationInput{Name: "Engineering"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := c.CreateTeam(ctx, gqlclient.CreateTeamMutationInput{Name: "Payments", Parent: &parent.Slug})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.Parent == nil || child.Parent.Slug != "engineering" {
+		t.Errorf("unexpected team %+v", child)
+	}
+	if err := c.AddTeamMembers(ctx, gqlclient.AddTeamMembersMutationInput{Slug: child.Slug, Members: []string{user.ID}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddTeamMembers(ctx, gqlclient.AddTeamMembersMutationInput{Slug: child.Slug, Members: []string{"404"}}); err == nil {
+		t.Error("expected an error adding an unknown user")
+	}
+
+	if err := c.DeleteTeam(ctx, &child.Slug); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTeam(ctx, &child.Slug); !errors.Is(err, gqlclient.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a deleted team, got %v", err)
+	}
+
+	org, err := c.GetOrganization(ctx)
+	if err != nil || org.Slug != "acme" {
+		t.Errorf("unexpected organization %+v, %v", org, err)
+	}
+}
+
+func TestRejectsInvalidAPIKey(t *testing.T) {
+	server := NewServer("test-key", "acme")
+	defer server.Close()
+
+	apiKey := "wrong"
+	c, _ := gqlclient.NewClient(&server.URL, &apiKey, "acme", "test", 10*time.Second, gqlclient.RetryPolicy{})
+	if _, err := c.GetOrganization(context.Background()); err == nil {
+		t.Error("expected an error for an invalid API key")
+	}
+}
+
+func TestOrgScopedQueriesUseTheConfiguredOrganization(t *testing.T) {
+	server := NewServer("test-key", "acme")
+	defer server.Close()
+
+	apiKey := "test-key"
+	c, _ := gqlclient.NewClient(&server.URL, &apiKey, "other", "test", 10*time.Second, gqlclient.RetryPolicy{})
+	if _, err := c.GetProjects(context.Background()); err == nil {
+		t.Error("expected an error listing the projects of another organization")
+	}
+	if _, err := c.GetOrganization(context.Background()); err == nil {
+		t.Error("expected an error reading another organization")
+	}
+
+	c.OrgSlug = ""
+	if org, err := c.GetOrganization(context.Background()); err != nil || org.Slug != "acme" {
+		t.Errorf("expected the organization of the API key, got %+v, %v", org, err)
+	}
+}
+
+func TestParseOperation(t *testing.T) {
+	op, err := parseOperation(`mutation($input:CreateTeamMutationInput!){createTeam(input: $input){team{id,slug},errors{field,messages}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.kind != "mutation" || op.selections[0].name != "createTeam" || len(op.selections[0].selections) != 2 {
+		t.Errorf("unexpected operation %+v", op)
+	}
+
+	op, err = parseOperation(`{ p: project(projectSlug: "payments", page: 2) { ... on Project { slug } } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := op.selections[0]
+	if sel.alias != "p" || sel.arguments["projectSlug"] != "payments" || sel.arguments["page"] != float64(2) {
+		t.Errorf("unexpected selection %+v", sel)
+	}
+	if !sel.selections[0].fragment || sel.selections[0].typeCondition != "Project" {
+		t.Errorf("expected an inline fragment, got %+v", sel.selections[0])
+	}
+
+	if _, err := parseOperation(`query { project(`); err == nil {
+		t.Error("expected an error for an unterminated document")
+	}
+}
@@ -0,0 +1,220 @@
+package mockserver
+
+import (
+	"fmt"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+type team struct {
+	gqlclient.Team
+
+	// members are user IDs
+	members []string
+}
+
+func (s *Server) resolveOrganization(args map[string]interface{}) (interface{}, error) {
+	if orgSlug := stringArgument(args, "orgSlug"); orgSlug != "" && orgSlug != s.org.Slug {
+		return nil, fmt.Errorf("Organization not found")
+	}
+	obj := toObject(s.org, "Organization")
+	obj["users"] = fieldResolver(s.resolveUsers)
+	obj["integrationAuths"] = fieldResolver(s.resolveIntegrationAuths)
+	return obj, nil
+}
+
+// resolveUsers lists the organization members, filtered by email or by a term matching the email or username
+func (s *Server) resolveUsers(args map[string]interface{}) (interface{}, error) {
+	emails := map[string]bool{}
+	if list, ok := args["emails"].([]interface{}); ok {
+		for _, email := range list {
+			emails[fmt.Sprint(email)] = true
+		}
+	}
+	term := stringArgument(args, "term")
+
+	var users []gqlclient.User
+	for _, user := range s.users {
+		if len(emails) > 0 && !emails[user.Email] {
+			continue
+		}
+		if term != "" && !containsFold(user.Email, term) && !containsFold(user.Username, term) {
+			continue
+		}
+		users = append(users, user)
+	}
+	return paginate(users, args), nil
+}
+
+func (s *Server) resolveTeam(args map[string]interface{}) (interface{}, error) {
+	if orgSlug := stringArgument(args, "orgSlug"); orgSlug != "" && orgSlug != s.org.Slug {
+		return nil, fmt.Errorf("Organization not found")
+	}
+	t, found := s.teams[stringArgument(args, "teamSlug")]
+	if !found {
+		return nil, fmt.Errorf("Team not found")
+	}
+
+	obj := toObject(t.Team, "Team")
+	obj["members"] = fieldResolver(func(args map[string]interface{}) (interface{}, error) {
+		var members []gqlclient.User
+		for _, id := range t.members {
+			if user := s.findUser(id); user != nil {
+				members = append(members, *user)
+			}
+		}
+		return paginate(members, args), nil
+	})
+	return obj, nil
+}
+
+func (s *Server) findUser(id string) *gqlclient.User {
+	for idx := range s.users {
+		if s.users[idx].ID == id {
+			return &s.users[idx]
+		}
+	}
+	return nil
+}
+
+func (s *Server) createTeam(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.CreateTeamMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	if input.Name == "" {
+		return payload("team", nil, fieldErrors("name", "This field is required.")), nil
+	}
+
+	t := &team{Team: gqlclient.Team{
+		ID:   s.nextID(),
+		Slug: uniqueSlug(input.Name, func(slug string) bool { return s.teams[slug] != nil }),
+		Name: input.Name,
+	}}
+	if errors := s.setTeamParent(t, input.Parent); len(errors) > 0 {
+		return payload("team", nil, errors), nil
+	}
+	s.teams[t.Slug] = t
+	return payload("team", toObject(t.Team, "Team"), nil), nil
+}
+
+func (s *Server) updateTeam(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.UpdateTeamMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	t, found := s.teams[input.Slug]
+	if !found {
+		return payload("team", nil, fieldErrors("slug", "Team not found")), nil
+	}
+
+	if input.Name != nil {
+		if *input.Name == "" {
+			return payload("team", nil, fieldErrors("name", "This field is required.")), nil
+		}
+		t.Name = *input.Name
+	}
+	if input.Parent != nil {
+		if errors := s.setTeamParent(t, input.Parent); len(errors) > 0 {
+			return payload("team", nil, errors), nil
+		}
+	}
+	return payload("team", toObject(t.Team, "Team"), nil), nil
+}
+
+// setTeamParent sets the parent of t, an empty slug removes it
+func (s *Server) setTeamParent(t *team, parentSlug *string) gqlclient.ErrorsType {
+	if parentSlug == nil || *parentSlug == "" {
+		t.Parent = nil
+		return nil
+	}
+	if *parentSlug == t.Slug {
+		return fieldErrors("parent", "A team can not be its own parent")
+	}
+	if s.teams[*parentSlug] == nil {
+		return fieldErrors("parent", fmt.Sprintf("Team %s not found", *parentSlug))
+	}
+	t.Parent = &struct {
+		Slug string `json:"slug"`
+	}{Slug: *parentSlug}
+	return nil
+}
+
+func (s *Server) deleteTeam(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.DeleteTeamMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	if s.teams[input.Slug] == nil {
+		return success(false), nil
+	}
+	delete(s.teams, input.Slug)
+	for _, t := range s.teams {
+		if t.Parent != nil && t.Parent.Slug == input.Slug {
+			t.Parent = nil
+		}
+	}
+	return success(true), nil
+}
+
+func (s *Server) addTeamMembers(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.AddTeamMembersMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	t, found := s.teams[input.Slug]
+	if !found {
+		return object{"success": false, "errors": toObjects(fieldErrors("slug", "Team not found"))}, nil
+	}
+
+	for _, id := range input.Members {
+		if s.findUser(id) == nil {
+			return object{"success": false, "errors": toObjects(fieldErrors("members", fmt.Sprintf("User %s not found", id)))}, nil
+		}
+	}
+	for _, id := range input.Members {
+		if !contains(t.members, id) {
+			t.members = append(t.members, id)
+		}
+	}
+	return success(true), nil
+}
+
+func (s *Server) removeTeamMembers(args map[string]interface{}) (interface{}, error) {
+	var input gqlclient.RemoveTeamMembersMutationInput
+	if err := decodeArgument(args, "input", &input); err != nil {
+		return nil, err
+	}
+	t, found := s.teams[input.Slug]
+	if !found {
+		return object{"success": false, "errors": toObjects(fieldErrors("slug", "Team not found"))}, nil
+	}
+
+	var members []string
+	for _, id := range t.members {
+		if !contains(input.Members, id) {
+			members = append(members, id)
+		}
+	}
+	t.members = members
+	return success(true), nil
+}
+
+func paginate(users []gqlclient.User, args map[string]interface{}) object {
+	page := intArgument(args, "page", 1)
+	pageSize := intArgument(args, "pageSize", 50)
+	start := (page - 1) * pageSize
+	if start > len(users) {
+		start = len(users)
+	}
+	end := start + pageSize
+	if end > len(users) {
+		end = len(users)
+	}
+
+	var objects []object
+	for _, user := range users[start:end] {
+		objects = append(objects, toObject(user, "User"))
+	}
+	return object{"objects": objects, "hasNext": end < len(users)}
+}
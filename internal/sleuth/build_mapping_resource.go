@@ -0,0 +1,376 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ resource.Resource                   = &buildMappingResource{}
+	_ resource.ResourceWithConfigure      = &buildMappingResource{}
+	_ resource.ResourceWithImportState    = &buildMappingResource{}
+	_ resource.ResourceWithModifyPlan     = &buildMappingResource{}
+	_ resource.ResourceWithValidateConfig = &buildMappingResource{}
+)
+
+type buildMappingResourceModel struct {
+	ID                       types.String               `tfsdk:"id"`
+	ProjectSlug              types.String               `tfsdk:"project_slug"`
+	CodeChangeSourceSlug     types.String               `tfsdk:"code_change_source_slug"`
+	EnvironmentSlug          types.String               `tfsdk:"environment_slug"`
+	ProviderType             caseInsensitiveStringValue `tfsdk:"provider_type"`
+	IntegrationSlug          types.String               `tfsdk:"integration_slug"`
+	BuildName                types.String               `tfsdk:"build_name"`
+	JobName                  types.String               `tfsdk:"job_name"`
+	ProjectKey               types.String               `tfsdk:"project_key"`
+	ProjectName              types.String               `tfsdk:"project_name"`
+	MatchBranchToEnvironment types.Bool                 `tfsdk:"match_branch_to_environment"`
+	IsCustom                 types.Bool                 `tfsdk:"is_custom"`
+}
+
+// buildMapping returns the mapping as an element of the build_mappings of a code change source
+func (m buildMappingResourceModel) buildMapping() buildMappingsResourceModel {
+	return buildMappingsResourceModel{
+		EnvironmentSlug:          m.EnvironmentSlug,
+		Provider:                 m.ProviderType,
+		IntegrationSlug:          m.IntegrationSlug,
+		BuildName:                m.BuildName,
+		JobName:                  m.JobName,
+		ProjectKey:               m.ProjectKey,
+		ProjectName:              m.ProjectName,
+		MatchBranchToEnvironment: m.MatchBranchToEnvironment,
+		IsCustom:                 m.IsCustom,
+	}
+}
+
+type buildMappingResource struct {
+	c *gqlclient.Client
+}
+
+func NewBuildMappingResource() resource.Resource {
+	return &buildMappingResource{}
+}
+
+func (bmr *buildMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+	attributes := buildMappingAttributes()
+	// provider is a reserved root attribute name
+	attributes["provider_type"] = attributes["provider"]
+	delete(attributes, "provider")
+	for _, name := range []string{"environment_slug", "build_name"} {
+		attribute := attributes[name].(schema.StringAttribute)
+		attribute.PlanModifiers = []planmodifier.String{stringplanmodifier.RequiresReplace()}
+		attributes[name] = attribute
+	}
+	for _, name := range []string{"integration_slug", "project_key"} {
+		attribute := attributes[name].(schema.StringAttribute)
+		attribute.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		attributes[name] = attribute
+	}
+	// unlike elements of the build_mappings set, the attributes of this resource have a stable path defaults apply to
+	matchBranchToEnvironment := attributes["match_branch_to_environment"].(schema.BoolAttribute)
+	matchBranchToEnvironment.Default = booldefault.StaticBool(true)
+	attributes["match_branch_to_environment"] = matchBranchToEnvironment
+	isCustom := attributes["is_custom"].(schema.BoolAttribute)
+	isCustom.Default = booldefault.StaticBool(false)
+	attributes["is_custom"] = isCustom
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "`project_slug/code_change_source_slug/environment_slug/build_name`",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["project_slug"] = schema.StringAttribute{
+		MarkdownDescription: "The slug of the project of the code change source",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["code_change_source_slug"] = schema.StringAttribute{
+		MarkdownDescription: "The slug of the code change source to add the mapping to",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Maps a build to an environment of an existing code change source, for when the code change source and its " +
+			"environments are owned by different configurations. The environment must already be mapped to a branch, with a " +
+			"`sleuth_environment_mapping` or in the `environment_mappings` of the code change source. Set `ignore_external_mappings` on " +
+			"the `sleuth_code_change_source` so it leaves the mapping alone. " +
+			"Mappings are saved by replacing all mappings of the code change source. The code change source is read again after saving, " +
+			"and the mapping is saved again when an apply of another configuration undid it at the same time.",
+		Attributes: attributes,
+	}
+}
+
+func (bmr *buildMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	bmr.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (bmr *buildMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_build_mapping"
+}
+
+// ValidateConfig validates that only one of `project_key` and `project_name` is set
+func (bmr *buildMappingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var projectKey, projectName types.String
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_key"), &projectKey)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_name"), &projectName)...)
+	if !res.Diagnostics.HasError() && isSet(projectKey) && isSet(projectName) {
+		res.Diagnostics.AddAttributeError(
+			path.Root("project_name"),
+			"Conflicting build project",
+			"project_key and project_name cannot both be set, set only one of them",
+		)
+	}
+}
+
+func (bmr *buildMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	bmr.validateReferences(ctx, req, res)
+	if req.State.Raw.IsNull() || res.Diagnostics.HasError() {
+		return
+	}
+
+	var configProjectKey, planProjectName, stateProjectName types.String
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_key"), &configProjectKey)...)
+	res.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_name"), &planProjectName)...)
+	res.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_name"), &stateProjectName)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// the key project_name resolves to is only known after apply if project_name changed
+	if configProjectKey.IsNull() && !planProjectName.Equal(stateProjectName) {
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("project_key"), types.StringUnknown())...)
+	}
+}
+
+// validateReferences fails the plan when the project, code change source, environment or integration of the mapping
+// does not exist, see validate_references_on_plan
+func (bmr *buildMappingResource) validateReferences(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	references, ok := newReferenceValidator(bmr.c, req, res)
+	if !ok {
+		return
+	}
+	var plan buildMappingResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	if references.project(ctx, path.Root("project_slug"), plan.ProjectSlug) {
+		references.codeChangeSource(ctx, path.Root("code_change_source_slug"), plan.ProjectSlug.ValueString(), plan.CodeChangeSourceSlug)
+		references.environment(ctx, path.Root("environment_slug"), plan.ProjectSlug.ValueString(), plan.EnvironmentSlug)
+	}
+	references.integration(ctx, path.Root("integration_slug"), plan.IntegrationSlug)
+}
+
+func (bmr *buildMappingResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "resource", "build_mapping")
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan buildMappingResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating BuildMapping resource", map[string]any{"plan": plan})
+	environmentSlug := plan.EnvironmentSlug.ValueString()
+	buildName := plan.BuildName.ValueString()
+	ccs, err := bmr.c.UpdateCodeChangeSourceMappings(ctx, plan.ProjectSlug.ValueString(), plan.CodeChangeSourceSlug.ValueString(), func(ccs *gqlclient.MutableCodeChangeSource) error {
+		if findBuildMapping(ccs.BuildMappings, environmentSlug, buildName) >= 0 {
+			return fmt.Errorf("build %s is already mapped to environment %s, import the mapping instead", buildName, environmentSlug)
+		}
+		idx := findBranchMapping(ccs.EnvironmentMappings, environmentSlug)
+		if idx < 0 {
+			return fmt.Errorf("environment %s is not mapped to a branch, map it before mapping builds to it", environmentSlug)
+		}
+		ccs.BuildMappings = append(ccs.BuildMappings, getBuildMappingInput(plan.buildMapping(), ccs.EnvironmentMappings[idx].Branch))
+		return nil
+	})
+	if err != nil {
+		tflog.Error(ctx, "Error creating BuildMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error creating BuildMapping", fmt.Sprintf("Could not create build mapping: %+v", err.Error()))
+		return
+	}
+
+	state, found := getNewStateFromBuildMapping(ccs, plan)
+	if !found {
+		res.Diagnostics.AddError("Error creating BuildMapping", fmt.Sprintf("Build mapping of %s to %s was not saved", buildName, environmentSlug))
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+func (bmr *buildMappingResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "resource", "build_mapping")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state buildMappingResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	projectSlug := state.ProjectSlug.ValueString()
+	slug := state.CodeChangeSourceSlug.ValueString()
+	ccs, err := bmr.c.GetCodeChangeSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "CodeChangeSource not found, removing BuildMapping from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error reading BuildMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error reading BuildMapping", fmt.Sprintf("Could not read code change source %s/%s: %+v", projectSlug, slug, err.Error()))
+		return
+	}
+
+	newState, found := getNewStateFromBuildMapping(ccs, state)
+	if !found {
+		tflog.Warn(ctx, "BuildMapping not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, newState)...)
+}
+
+func (bmr *buildMappingResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "resource", "build_mapping")
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan buildMappingResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating BuildMapping resource", map[string]any{"plan": plan})
+	environmentSlug := plan.EnvironmentSlug.ValueString()
+	buildName := plan.BuildName.ValueString()
+	ccs, err := bmr.c.UpdateCodeChangeSourceMappings(ctx, plan.ProjectSlug.ValueString(), plan.CodeChangeSourceSlug.ValueString(), func(ccs *gqlclient.MutableCodeChangeSource) error {
+		idx := findBuildMapping(ccs.BuildMappings, environmentSlug, buildName)
+		if idx < 0 {
+			return errMappingNotFound
+		}
+		ccs.BuildMappings[idx] = getBuildMappingInput(plan.buildMapping(), ccs.BuildMappings[idx].BuildBranch)
+		return nil
+	})
+	if err != nil {
+		tflog.Error(ctx, "Error updating BuildMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error updating BuildMapping", fmt.Sprintf("Could not update build mapping of %s to %s: %+v", buildName, environmentSlug, err.Error()))
+		return
+	}
+
+	state, found := getNewStateFromBuildMapping(ccs, plan)
+	if !found {
+		res.Diagnostics.AddError("Error updating BuildMapping", fmt.Sprintf("Build mapping of %s to %s was not saved", buildName, environmentSlug))
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+func (bmr *buildMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "resource", "build_mapping")
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state buildMappingResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting BuildMapping resource", map[string]any{"state": state})
+	environmentSlug := state.EnvironmentSlug.ValueString()
+	buildName := state.BuildName.ValueString()
+	_, err := bmr.c.UpdateCodeChangeSourceMappings(ctx, state.ProjectSlug.ValueString(), state.CodeChangeSourceSlug.ValueString(), func(ccs *gqlclient.MutableCodeChangeSource) error {
+		idx := findBuildMapping(ccs.BuildMappings, environmentSlug, buildName)
+		if idx < 0 {
+			return errMappingNotFound
+		}
+		ccs.BuildMappings = slices.Delete(ccs.BuildMappings, idx, idx+1)
+		return nil
+	})
+	if errors.Is(err, gqlclient.ErrNotFound) || errors.Is(err, errMappingNotFound) {
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error deleting BuildMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error deleting BuildMapping", fmt.Sprintf("Could not delete build mapping of %s to %s: %+v", buildName, environmentSlug, err.Error()))
+	}
+}
+
+func (bmr *buildMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	// build mappings are imported by `project_slug/code_change_source_slug/environment_slug/build_name`
+	parts, err := parseMappingImportID(req.ID, "project_slug", "code_change_source_slug", "environment_slug", "build_name")
+	if err != nil {
+		res.Diagnostics.AddError("Error importing BuildMapping", err.Error())
+		return
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("code_change_source_slug"), parts[1])...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("environment_slug"), parts[2])...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("build_name"), parts[3])...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// findBuildMapping returns the index of the mapping of buildName to environmentSlug, or -1
+func findBuildMapping(mappings []gqlclient.BuildMapping, environmentSlug, buildName string) int {
+	return slices.IndexFunc(mappings, func(bm gqlclient.BuildMapping) bool {
+		return bm.EnvironmentSlug == environmentSlug && bm.BuildName == buildName
+	})
+}
+
+// getNewStateFromBuildMapping returns the state of the mapping of the build of prior in ccs, and false if ccs does not
+// map it
+func getNewStateFromBuildMapping(ccs *gqlclient.CodeChangeSource, prior buildMappingResourceModel) (buildMappingResourceModel, bool) {
+	idx := slices.IndexFunc(ccs.DeployTrackingBuildMappings, func(bm gqlclient.DeployTrackingBuildMapping) bool {
+		return bm.Environment.Slug == prior.EnvironmentSlug.ValueString() && bm.BuildName == prior.BuildName.ValueString()
+	})
+	if idx < 0 {
+		return buildMappingResourceModel{}, false
+	}
+
+	bm := ccs.DeployTrackingBuildMappings[idx]
+	projectSlug := prior.ProjectSlug.ValueString()
+	state := getBuildMappingState(bm)
+	return buildMappingResourceModel{
+		ID:                       types.StringValue(fmt.Sprintf("%s/%s/%s/%s", projectSlug, ccs.Slug, bm.Environment.Slug, bm.BuildName)),
+		ProjectSlug:              types.StringValue(projectSlug),
+		CodeChangeSourceSlug:     types.StringValue(ccs.Slug),
+		EnvironmentSlug:          state.EnvironmentSlug,
+		ProviderType:             state.Provider,
+		IntegrationSlug:          state.IntegrationSlug,
+		BuildName:                state.BuildName,
+		JobName:                  state.JobName,
+		ProjectKey:               state.ProjectKey,
+		ProjectName:              state.ProjectName,
+		MatchBranchToEnvironment: state.MatchBranchToEnvironment,
+		IsCustom:                 state.IsCustom,
+	}, true
+}
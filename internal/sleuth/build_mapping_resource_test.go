@@ -0,0 +1,114 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBuildMappingResource(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectName := fmt.Sprintf("Terraform test project %s", randomStr)
+	projectSlug := fmt.Sprintf("terraform-test-project-%s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      buildMappingConfig(projectName, `project_key = "sleuth-io/terraform-provider-sleuth"`),
+				ExpectError: regexp.MustCompile(`project_key and project_name cannot both be set`),
+			},
+			{
+				Config: buildMappingConfig(projectName, `job_name = "deploy"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "id", fmt.Sprintf("%s/terraform-code-change-source/staging/release", projectSlug)),
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "provider_type", "github"),
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "job_name", "deploy"),
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "project_name", "sleuth-io/terraform-provider-sleuth"),
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "project_key", "sleuth-io/terraform-provider-sleuth"),
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "match_branch_to_environment", "true"),
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "is_custom", "false"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.#", "1"),
+				),
+			},
+			{
+				Config: buildMappingConfig(projectName, `match_branch_to_environment = false`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("sleuth_build_mapping.release", "job_name"),
+					resource.TestCheckResourceAttr("sleuth_build_mapping.release", "match_branch_to_environment", "false"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "sleuth_build_mapping.release",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API returns providers in upper case
+				ImportStateVerifyIgnore: []string{"provider_type"},
+			},
+		},
+	})
+}
+
+func buildMappingConfig(projectName, buildMappingAttributes string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_environment" "staging" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "staging"
+}
+
+resource "sleuth_code_change_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "Terraform code change source"
+	repository = {
+		name = "terraform-provider-sleuth"
+		owner = "sleuth-io"
+		provider = "GITHUB"
+		url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+	}
+	ignore_external_mappings = true
+	environment_mappings = [
+		{
+			environment_slug = "production"
+			branch = "main"
+		}
+	]
+	build_mappings = [
+		{
+			environment_slug = "production"
+			build_name = "release"
+			provider = "GITHUB"
+		}
+	]
+	deploy_tracking_type = "build"
+}
+
+resource "sleuth_environment_mapping" "staging" {
+	project_slug            = sleuth_project.terraform_acc_test.slug
+	code_change_source_slug = sleuth_code_change_source.terraform_acc_test.slug
+	environment_slug        = sleuth_environment.staging.slug
+	branch                  = "develop"
+}
+
+resource "sleuth_build_mapping" "release" {
+	project_slug            = sleuth_project.terraform_acc_test.slug
+	code_change_source_slug = sleuth_code_change_source.terraform_acc_test.slug
+	environment_slug        = sleuth_environment_mapping.staging.environment_slug
+	build_name              = "release"
+	provider_type           = "github"
+	project_name            = "sleuth-io/terraform-provider-sleuth"
+	%s
+}
+`, projectName, buildMappingAttributes)
+}
@@ -0,0 +1,109 @@
+package sleuth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = caseInsensitiveStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = caseInsensitiveStringValue{}
+)
+
+// caseInsensitiveStringType is the type of provider and other enum-like attributes. Its values are equal when they only
+// differ in case, so the case the API returns a value in does not show up as a change and the configured one is kept.
+type caseInsensitiveStringType struct {
+	basetypes.StringType
+}
+
+func (t caseInsensitiveStringType) Equal(o attr.Type) bool {
+	other, ok := o.(caseInsensitiveStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t caseInsensitiveStringType) String() string {
+	return "caseInsensitiveStringType"
+}
+
+func (t caseInsensitiveStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return caseInsensitiveStringValue{StringValue: in}, nil
+}
+
+func (t caseInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return caseInsensitiveStringValue{StringValue: stringValue}, nil
+}
+
+func (t caseInsensitiveStringType) ValueType(_ context.Context) attr.Value {
+	return caseInsensitiveStringValue{}
+}
+
+type caseInsensitiveStringValue struct {
+	basetypes.StringValue
+}
+
+func newCaseInsensitiveStringValue(value string) caseInsensitiveStringValue {
+	return caseInsensitiveStringValue{StringValue: types.StringValue(value)}
+}
+
+func newCaseInsensitiveStringNull() caseInsensitiveStringValue {
+	return caseInsensitiveStringValue{StringValue: types.StringNull()}
+}
+
+func (v caseInsensitiveStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(caseInsensitiveStringValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v caseInsensitiveStringValue) Type(_ context.Context) attr.Type {
+	return caseInsensitiveStringType{}
+}
+
+// upper returns the value in the uppercase the API expects
+func (v caseInsensitiveStringValue) upper() string {
+	return strings.ToUpper(v.ValueString())
+}
+
+func (v caseInsensitiveStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(caseInsensitiveStringValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T, got %T", v, newValuable))
+		return false, diags
+	}
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), diags
+}
+
+// caseInsensitiveRequiresReplace returns a plan modifier replacing the resource when the value changes other than in
+// case, for which stringplanmodifier.RequiresReplace would replace an imported resource configured in another case
+func caseInsensitiveRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, res *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			res.RequiresReplace = !strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString())
+		},
+		"Changing the value other than in case requires replacement.",
+		"Changing the value other than in case requires replacement.",
+	)
+}
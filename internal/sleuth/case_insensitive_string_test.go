@@ -0,0 +1,30 @@
+package sleuth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCaseInsensitiveStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		a, b     caseInsensitiveStringValue
+		expected bool
+	}{
+		{a: newCaseInsensitiveStringValue("github"), b: newCaseInsensitiveStringValue("GITHUB"), expected: true},
+		{a: newCaseInsensitiveStringValue("Very_Fine"), b: newCaseInsensitiveStringValue("VERY_FINE"), expected: true},
+		{a: newCaseInsensitiveStringValue("github"), b: newCaseInsensitiveStringValue("gitlab"), expected: false},
+		{a: newCaseInsensitiveStringValue(""), b: newCaseInsensitiveStringValue("NONE"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a.ValueString()+" "+tt.b.ValueString(), func(t *testing.T) {
+			equal, diags := tt.a.StringSemanticEquals(context.Background(), tt.b)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &codeChangeSourceDataSource{}
+	_ datasource.DataSourceWithConfigure = &codeChangeSourceDataSource{}
+)
+
+type codeChangeSourceDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewCodeChangeSourceDataSource() datasource.DataSource {
+	return &codeChangeSourceDataSource{}
+}
+
+func (ccsd *codeChangeSourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Sleuth code change source by project and slug.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project that this code change source belongs to.",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Code change source slug",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Code change source name",
+				Computed:            true,
+			},
+			"deploy_tracking_type": schema.StringAttribute{
+				MarkdownDescription: "How deploys are tracked",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"collect_impact": schema.BoolAttribute{
+				MarkdownDescription: "Whether impact is collected for its deploys",
+				Computed:            true,
+			},
+			"path_prefix": schema.StringAttribute{
+				MarkdownDescription: "What code source path this deployment is limited to, as JSON",
+				CustomType:          pathPrefixType{},
+				Computed:            true,
+			},
+			"path_filters": schema.SingleNestedAttribute{
+				MarkdownDescription: "What code source paths this deployment is limited to. Null when `path_prefix` is not a path filter document.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"include": schema.ListAttribute{
+						MarkdownDescription: "Paths whose changes are tracked",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"exclude": schema.ListAttribute{
+						MarkdownDescription: "Paths whose changes are ignored",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+			"notify_in_slack": schema.BoolAttribute{
+				MarkdownDescription: "Whether Slack notifications are sent for deploys",
+				Computed:            true,
+			},
+			"include_in_dashboard": schema.BoolAttribute{
+				MarkdownDescription: "Whether deploys from this change source are included in the metrics dashboard",
+				Computed:            true,
+			},
+			"auto_tracking_delay": schema.Int64Attribute{
+				MarkdownDescription: "The delay added to a deployment event",
+				Computed:            true,
+			},
+			"repository": schema.SingleNestedAttribute{
+				Description: "Repository details",
+				Computed:    true,
+				Attributes:  repositoryDataSourceAttributes(),
+			},
+			"environment_mappings": schema.ListNestedAttribute{
+				MarkdownDescription: "Environment mappings of the code change source",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"environment_slug": schema.StringAttribute{
+							MarkdownDescription: "The environment slug for mapping",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							MarkdownDescription: "The repository branch name for the environment",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Computed ID",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"build_mappings": schema.ListNestedAttribute{
+				MarkdownDescription: "Build mappings of the code change source",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"environment_slug": schema.StringAttribute{
+							MarkdownDescription: "The environment slug",
+							Computed:            true,
+						},
+						"provider": schema.StringAttribute{
+							MarkdownDescription: "The build provider",
+							CustomType:          caseInsensitiveStringType{},
+							Computed:            true,
+						},
+						"integration_slug": schema.StringAttribute{
+							MarkdownDescription: "IntegrationAuthentication slug used",
+							Computed:            true,
+						},
+						"build_name": schema.StringAttribute{
+							MarkdownDescription: "The remote build or pipeline name",
+							Computed:            true,
+						},
+						"job_name": schema.StringAttribute{
+							MarkdownDescription: "The job or stage within the build or pipeline, if supported",
+							Computed:            true,
+						},
+						"project_key": schema.StringAttribute{
+							MarkdownDescription: "The build project key",
+							Computed:            true,
+						},
+						"project_name": schema.StringAttribute{
+							MarkdownDescription: "The build project name, when the mapping was saved with one",
+							Computed:            true,
+						},
+						"match_branch_to_environment": schema.BoolAttribute{
+							MarkdownDescription: "Whether only builds performed on the branch mapped from the environment are tracked or not",
+							Computed:            true,
+						},
+						"is_custom": schema.BoolAttribute{
+							MarkdownDescription: "Whether this is a custom build mapping or not",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ccsd *codeChangeSourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	ccsd.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (ccsd *codeChangeSourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_code_change_source"
+}
+
+func (ccsd *codeChangeSourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "code_change_source")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config codeChangeResourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	projectSlug := config.ProjectSlug.ValueString()
+	slug := config.Slug.ValueString()
+	tflog.Info(ctx, "Reading CodeChangeSource data source", map[string]any{"projectSlug": projectSlug, "slug": slug})
+
+	ccs, err := ccsd.c.GetCodeChangeSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError(
+			"CodeChangeSource not found",
+			fmt.Sprintf("Could not find code change source %s in project %s", slug, projectSlug),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error reading CodeChangeSource", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError(
+			"Error reading CodeChangeSource",
+			fmt.Sprintf("Could not read code change source %s/%s: %+v", projectSlug, slug, err.Error()),
+		)
+		return
+	}
+
+	// There is no plan to preserve values from, so the repository provider is taken as returned by the API
+	lookup := codeChangeResourceModel{
+		Repository: &repositoryResourceModel{Provider: newCaseInsensitiveStringValue(ccs.Repository.Provider)},
+	}
+	state, diags := getNewStateFromCodeChangeSource(ctx, ccs, projectSlug, lookup)
+	res.Diagnostics.Append(diags...)
+
+	// The data source has both the provider attributes and the block of the provider, with its full URL
+	if idx, ok := findRepositoryBlock(ccs.Repository.Provider); ok {
+		*state.Repository.blocks()[idx], diags = getRepositoryBlockValue(repositoryBlocks[idx], ccs.Repository.RepositoryBase, false)
+		res.Diagnostics.Append(diags...)
+	}
+
+	for idx, bm := range ccs.DeployTrackingBuildMappings {
+		if bm.BuildProjectKey != "" {
+			state.BuildMappings[idx].ProjectKey = types.StringValue(bm.BuildProjectKey)
+		}
+	}
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
+
+func repositoryDataSourceAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"owner": schema.StringAttribute{
+			MarkdownDescription: "The repository owner, usually the organization or user name",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The repository name",
+			Computed:            true,
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "The repository URL, used for links",
+			Computed:            true,
+		},
+		"provider": schema.StringAttribute{
+			MarkdownDescription: "The repository provider",
+			CustomType:          caseInsensitiveStringType{},
+			Computed:            true,
+		},
+		"integration_slug": schema.StringAttribute{
+			MarkdownDescription: "IntegrationAuthentication slug used",
+			Computed:            true,
+		},
+		"repo_uid": schema.StringAttribute{
+			MarkdownDescription: "Repository UID, only set for AZURE provider",
+			Computed:            true,
+		},
+		"project_uid": schema.StringAttribute{
+			MarkdownDescription: "Project UID, only set for AZURE provider",
+			Computed:            true,
+		},
+		"webhook": schema.SingleNestedAttribute{
+			MarkdownDescription: "Webhook configuration for registering deploys from code integrations in read-only mode",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					MarkdownDescription: "Webhook URL",
+					Computed:            true,
+				},
+				"secret": schema.StringAttribute{
+					MarkdownDescription: "Webhook secret to present in payloads sent to the webhook URL",
+					Computed:            true,
+					Sensitive:           true,
+				},
+			},
+		},
+	}
+
+	for _, block := range repositoryBlocks {
+		blockAttributes := map[string]schema.Attribute{
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The repository owner, usually the organization, group, workspace or user name",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The repository name",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The repository URL, used for links",
+				Computed:            true,
+			},
+		}
+		if block.azure {
+			blockAttributes["project_uid"] = schema.StringAttribute{
+				MarkdownDescription: "Project UID",
+				Computed:            true,
+			}
+			blockAttributes["repo_uid"] = schema.StringAttribute{
+				MarkdownDescription: "Repository UID",
+				Computed:            true,
+			}
+		}
+		attributes[block.attribute] = schema.SingleNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("The repository, when it is hosted on %s", block.title),
+			Computed:            true,
+			Attributes:          blockAttributes,
+		}
+	}
+	return attributes
+}
@@ -0,0 +1,83 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccCodeChangeSourceDataSource_v6(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random name so slugs don't collide
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+	projectSlug := fmt.Sprintf("terraform-test-project-%s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: codeChangeSourceDataSourceConfig(projectString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "project_slug", projectSlug),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "name", "Terraform code change source"),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "deploy_tracking_type", "build"),
+					resource.TestMatchResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "repository.provider", regexp.MustCompile(`(?i)^GITHUB$`)),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "repository.owner", "sleuth-io"),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "repository.github.url", "https://github.com/sleuth-io/terraform-provider-sleuth"),
+					resource.TestCheckNoResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "repository.gitlab.%"),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "environment_mappings.#", "1"),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "environment_mappings.0.branch", "main"),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "build_mappings.#", "1"),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "build_mappings.0.build_name", "release"),
+					resource.TestCheckResourceAttr("data.sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_key", "sleuth-io/terraform-provider-sleuth"),
+				),
+			},
+		},
+	})
+}
+
+func codeChangeSourceDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_code_change_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "Terraform code change source"
+	repository = {
+		name = "terraform-provider-sleuth"
+		owner = "sleuth-io"
+		provider = "GITHUB"
+		url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+	}
+	environment_mappings = [
+		{
+			environment_slug = "production"
+			branch = "main"
+		}
+	]
+	build_mappings = [
+		{
+			environment_slug = "production"
+			build_name = "release"
+			project_key = "sleuth-io/terraform-provider-sleuth"
+			provider = "GITHUB"
+		}
+	]
+	deploy_tracking_type = "build"
+}
+
+data "sleuth_code_change_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	slug = sleuth_code_change_source.terraform_acc_test.slug
+}
+`, name)
+}
@@ -2,7 +2,10 @@ package sleuth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -11,20 +14,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/validators"
 )
 
 var (
-	_ resource.Resource                = &codeChangeSourceResource{}
-	_ resource.ResourceWithConfigure   = &codeChangeSourceResource{}
-	_ resource.ResourceWithImportState = &codeChangeSourceResource{}
+	_ resource.Resource                   = &codeChangeSourceResource{}
+	_ resource.ResourceWithConfigure      = &codeChangeSourceResource{}
+	_ resource.ResourceWithImportState    = &codeChangeSourceResource{}
+	_ resource.ResourceWithValidateConfig = &codeChangeSourceResource{}
+	_ resource.ResourceWithModifyPlan     = &codeChangeSourceResource{}
+	_ resource.ResourceWithUpgradeState   = &codeChangeSourceResource{}
 )
 
 type codeChangeResourceModel struct {
@@ -37,23 +48,60 @@ type codeChangeResourceModel struct {
 	EnvironmentMappings []environmentMappingsResourceModel `tfsdk:"environment_mappings"`
 	BuildMappings       []buildMappingsResourceModel       `tfsdk:"build_mappings"`
 
-	DeployTrackingType types.String `tfsdk:"deploy_tracking_type"`
-	CollectImpact      types.Bool   `tfsdk:"collect_impact"`
-	PathPrefix         types.String `tfsdk:"path_prefix"`
-	NotifyInSlack      types.Bool   `tfsdk:"notify_in_slack"`
-	IncludeInDashboard types.Bool   `tfsdk:"include_in_dashboard"`
-	AutoTrackingDelay  types.Int64  `tfsdk:"auto_tracking_delay"`
+	DeployTrackingType caseInsensitiveStringValue `tfsdk:"deploy_tracking_type"`
+	CollectImpact      types.Bool                 `tfsdk:"collect_impact"`
+	PathPrefix         pathPrefixValue            `tfsdk:"path_prefix"`
+	PathFilters        types.Object               `tfsdk:"path_filters"`
+	NotifyInSlack      types.Bool                 `tfsdk:"notify_in_slack"`
+	IncludeInDashboard types.Bool                 `tfsdk:"include_in_dashboard"`
+	AutoTrackingDelay  types.Int64                `tfsdk:"auto_tracking_delay"`
 }
 
+// codeChangeSourceResourceModel is the state of the code change source resource, with the attributes it has on top of
+// the ones shared with the data source
+type codeChangeSourceResourceModel struct {
+	codeChangeResourceModel
+	IgnoreExternalMappings types.Bool  `tfsdk:"ignore_external_mappings"`
+	InitializeChanges      types.Bool  `tfsdk:"initialize_changes"`
+	InitialHistoryDays     types.Int64 `tfsdk:"initial_history_days"`
+}
+
+// withDefaults fills in the defaults of the attributes the API does not return, which imported and upgraded sources
+// have no value for. initialize_changes is left null, as how these sources were created is not known.
+func (m codeChangeSourceResourceModel) withDefaults() codeChangeSourceResourceModel {
+	if m.IgnoreExternalMappings.IsNull() {
+		m.IgnoreExternalMappings = types.BoolValue(false)
+	}
+	return m
+}
+
+// createdWithInitialization reports whether the code change source of state was created by the resource with the
+// initialization settings in state. Imported and upgraded sources have no initialize_changes.
+func createdWithInitialization(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	var initializeChanges types.Bool
+	diags := state.GetAttribute(ctx, path.Root("initialize_changes"), &initializeChanges)
+	return !initializeChanges.IsNull(), diags
+}
+
+// initializationRequiresReplace is the description of the plan modifiers of the initialization settings
+const initializationRequiresReplace = "The code change source is replaced when the initialization settings it was created with change."
+
 type repositoryResourceModel struct {
-	Owner           types.String `tfsdk:"owner"`
-	Name            types.String `tfsdk:"name"`
-	URL             types.String `tfsdk:"url"`
-	Provider        types.String `tfsdk:"provider"`
-	IntegrationSlug types.String `tfsdk:"integration_slug"`
-	RepoUID         types.String `tfsdk:"repo_uid"`
-	ProjectUID      types.String `tfsdk:"project_uid"`
-	Webhook         types.Object `tfsdk:"webhook"`
+	Owner           types.String               `tfsdk:"owner"`
+	Name            types.String               `tfsdk:"name"`
+	URL             types.String               `tfsdk:"url"`
+	Provider        caseInsensitiveStringValue `tfsdk:"provider"`
+	IntegrationSlug types.String               `tfsdk:"integration_slug"`
+	RepoUID         types.String               `tfsdk:"repo_uid"`
+	ProjectUID      types.String               `tfsdk:"project_uid"`
+	Webhook         types.Object               `tfsdk:"webhook"`
+
+	GitHub           types.Object `tfsdk:"github"`
+	GitHubEnterprise types.Object `tfsdk:"github_enterprise"`
+	GitLab           types.Object `tfsdk:"gitlab"`
+	Bitbucket        types.Object `tfsdk:"bitbucket"`
+	Azure            types.Object `tfsdk:"azure"`
+	CustomGit        types.Object `tfsdk:"custom_git"`
 }
 
 type webhookResourceModel struct {
@@ -75,19 +123,24 @@ type environmentMappingsResourceModel struct {
 }
 
 type buildMappingsResourceModel struct {
-	EnvironmentSlug          types.String `tfsdk:"environment_slug"`
-	Provider                 types.String `tfsdk:"provider"`
-	IntegrationSlug          types.String `tfsdk:"integration_slug"`
-	BuildName                types.String `tfsdk:"build_name"`
-	JobName                  types.String `tfsdk:"job_name"`
-	ProjectKey               types.String `tfsdk:"project_key"`
-	ProjectName              types.String `tfsdk:"project_name"`
-	MatchBranchToEnvironment types.Bool   `tfsdk:"match_branch_to_environment"`
-	IsCustom                 types.Bool   `tfsdk:"is_custom"`
+	EnvironmentSlug          types.String               `tfsdk:"environment_slug"`
+	Provider                 caseInsensitiveStringValue `tfsdk:"provider"`
+	IntegrationSlug          types.String               `tfsdk:"integration_slug"`
+	BuildName                types.String               `tfsdk:"build_name"`
+	JobName                  types.String               `tfsdk:"job_name"`
+	ProjectKey               types.String               `tfsdk:"project_key"`
+	ProjectName              types.String               `tfsdk:"project_name"`
+	MatchBranchToEnvironment types.Bool                 `tfsdk:"match_branch_to_environment"`
+	IsCustom                 types.Bool                 `tfsdk:"is_custom"`
 }
 
 const azureProvider = "azure"
 
+var (
+	deployTrackingTypes = []string{"build", "manual", "auto_pr", "auto_tag", "auto_push"}
+	buildProviders      = []string{"AZURE", "BITBUCKET_PIPELINES", "BUILDKITE", "CIRCLECI", "GITHUB", "GITLAB", "JENKINS"}
+)
+
 type codeChangeSourceResource struct {
 	c *gqlclient.Client
 }
@@ -98,6 +151,8 @@ func NewCodeChangeSourceResource() resource.Resource {
 
 func (ccsr *codeChangeSourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
+		// version 1 changed environment_mappings and build_mappings from lists to sets
+		Version:             1,
 		MarkdownDescription: "Sleuth code change source.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -119,7 +174,11 @@ func (ccsr *codeChangeSourceResource) Schema(_ context.Context, _ resource.Schem
 			},
 			"deploy_tracking_type": schema.StringAttribute{
 				MarkdownDescription: "How to track deploys. Valid choices are build, manual, auto_pr, auto_tag, auto_push",
+				CustomType:          caseInsensitiveStringType{},
 				Required:            true,
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive(deployTrackingTypes...),
+				},
 			},
 			"collect_impact": schema.BoolAttribute{
 				MarkdownDescription: "Whether to collect impact for its deploys",
@@ -128,10 +187,30 @@ func (ccsr *codeChangeSourceResource) Schema(_ context.Context, _ resource.Schem
 				Default:             booldefault.StaticBool(true),
 			},
 			"path_prefix": schema.StringAttribute{
-				MarkdownDescription: "What code source path to limit this deployment to. Useful for monorepos. Must be used with the [jsonencode()](https://developer.hashicorp.com/terraform/language/functions/jsonencode) function to specify the paths to include and/or exclude respectively. (see example above)",
+				MarkdownDescription: "What code source path to limit this deployment to, as a JSON document built with the [jsonencode()](https://developer.hashicorp.com/terraform/language/functions/jsonencode) function. Deprecated, use `path_filters` instead.",
+				DeprecationMessage:  "Use path_filters instead.",
+				CustomType:          pathPrefixType{},
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString(""),
+			},
+			"path_filters": schema.SingleNestedAttribute{
+				MarkdownDescription: "What code source paths to limit this deployment to. Useful for monorepos. Paths are globs relative to the repository root, e.g. `services/api/*`.",
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"include": schema.ListAttribute{
+						MarkdownDescription: "Paths whose changes are tracked",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+					},
+					"exclude": schema.ListAttribute{
+						MarkdownDescription: "Paths whose changes are ignored",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+					},
+				},
 			},
 			"notify_in_slack": schema.BoolAttribute{
 				MarkdownDescription: "Whether to send Slack notifications for deploys or not",
@@ -152,132 +231,235 @@ func (ccsr *codeChangeSourceResource) Schema(_ context.Context, _ resource.Schem
 				Default:             int64default.StaticInt64(0),
 			},
 			"repository": schema.SingleNestedAttribute{
-				Description: "Repository details",
-				Required:    true,
-				Attributes: map[string]schema.Attribute{
-					"owner": schema.StringAttribute{
-						MarkdownDescription: "The repository owner, usually the organization or user name",
-						Required:            true,
-					},
-					"name": schema.StringAttribute{
-						MarkdownDescription: "The repository name",
-						Required:            true,
-					},
-					"url": schema.StringAttribute{
-						MarkdownDescription: "The repository URL, used for links",
-						Required:            true,
-					},
-					"provider": schema.StringAttribute{
-						MarkdownDescription: "The repository provider, options: AZURE, BITBUCKET, CUSTOM_GIT, GITHUB, GITHUB_ENTERPRISE, GITLAB",
-						Required:            true,
-					},
-					"integration_slug": schema.StringAttribute{
-						MarkdownDescription: "IntegrationAuthentication slug used",
-						Optional:            true,
-						Computed:            true,
-					},
-					"repo_uid": schema.StringAttribute{
-						MarkdownDescription: "Repository UID, required only for AZURE provider. You can obtain data from [API](https://learn.microsoft.com/en-us/rest/api/azure/devops/git/repositories/list?view=azure-devops-rest-6.0&tabs=HTTP)",
-						Optional:            true,
-					},
-					"project_uid": schema.StringAttribute{
-						MarkdownDescription: "Project UID, required only for AZURE provider. You can obtain data from [API](https://learn.microsoft.com/en-us/rest/api/azure/devops/git/repositories/list?view=azure-devops-rest-6.0&tabs=HTTP)",
-						Optional:            true,
-					},
-					"webhook": schema.SingleNestedAttribute{
-						MarkdownDescription: "Webhook configuration for registering deploys from code integrations in read-only mode",
-						Computed:            true,
-						Attributes: map[string]schema.Attribute{
-							"url": schema.StringAttribute{
-								MarkdownDescription: "Webhook URL",
-								Computed:            true,
-							},
-							"secret": schema.StringAttribute{
-								MarkdownDescription: "Webhook secret to present in payloads sent to the webhook URL",
-								Computed:            true,
-								Sensitive:           true,
-							},
-						},
-					},
+				MarkdownDescription: "Repository details. Set exactly one of the provider blocks: " + repositoryBlockNames + ".",
+				Required:            true,
+				Attributes:          repositoryAttributes(),
+			},
+			"initialize_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether to import the history of the repository when the code change source is created. " +
+					"Set it to false to only track changes from now on, which is faster for large repositories. " +
+					"Changing it replaces the code change source, unless it was imported. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.BoolRequest, res *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+						res.RequiresReplace, res.Diagnostics = createdWithInitialization(ctx, req.State)
+					}, initializationRequiresReplace, initializationRequiresReplace),
 				},
 			},
-			"environment_mappings": schema.ListNestedAttribute{
-				MarkdownDescription: "Environment mappings of the code change source. They must be ordered by environment_slug ascending to avoid Terraform plan changes.",
+			"initial_history_days": schema.Int64Attribute{
+				MarkdownDescription: "How many days of history to import when the code change source is created, all of it if not set. " +
+					"Only applies when `initialize_changes` is true. Changing it replaces the code change source, unless it was imported.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.Int64Request, res *int64planmodifier.RequiresReplaceIfFuncResponse) {
+						res.RequiresReplace, res.Diagnostics = createdWithInitialization(ctx, req.State)
+					}, initializationRequiresReplace, initializationRequiresReplace),
+				},
+			},
+			"ignore_external_mappings": schema.BoolAttribute{
+				MarkdownDescription: "Whether environment and build mappings that are not configured on this resource are left alone instead of removed. " +
+					"Set it when mappings of the code change source are managed with `sleuth_environment_mapping` and `sleuth_build_mapping`. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"environment_mappings": schema.SetNestedAttribute{
+				MarkdownDescription: "Environment mappings of the code change source, one per environment.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"environment_slug": schema.StringAttribute{
-							MarkdownDescription: "The environment slug for mapping",
-							Required:            true,
-						},
-						"branch": schema.StringAttribute{
-							MarkdownDescription: "The repository branch name for the environment",
-							Required:            true,
-						},
-						"id": schema.StringAttribute{
-							MarkdownDescription: "Computed ID",
-							Computed:            true,
-						},
-					},
+					Attributes: environmentMappingAttributes(),
 				},
 			},
-			"build_mappings": schema.ListNestedAttribute{
-				MarkdownDescription: "Build mappings of the code change source. They must be ordered by environment_slug ascending to avoid Terraform plan changes.",
+			"build_mappings": schema.SetNestedAttribute{
+				MarkdownDescription: "Build mappings of the code change source, one per environment and build name.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"environment_slug": schema.StringAttribute{
-							MarkdownDescription: "The environment slug",
-							Required:            true,
-						},
-						"provider": schema.StringAttribute{
-							MarkdownDescription: "The build provider. Options: AZURE, BITBUCKET_PIPELINES, BUILDKITE, CIRCLECI, GITHUB, GITLAB, JENKINS",
-							Required:            true,
-						},
-						"integration_slug": schema.StringAttribute{
-							MarkdownDescription: "IntegrationAuthentication slug used",
-							Optional:            true,
-							Computed:            true,
-						},
-						"build_name": schema.StringAttribute{
-							MarkdownDescription: "The remote build or pipeline name",
-							Required:            true,
-						},
-						"job_name": schema.StringAttribute{
-							MarkdownDescription: "The job or stage within the build or pipeline, if supported",
-							Optional:            true,
-						},
-						"project_key": schema.StringAttribute{
-							MarkdownDescription: "The build project key. If both project_key and project_name are provided, project_key takes precedence.",
-							Optional:            true,
-						},
-						"project_name": schema.StringAttribute{
-							MarkdownDescription: "The build project name. If both project_key and project_name are provided, project_key takes precedence.",
-							Optional:            true,
-						},
-						"match_branch_to_environment": schema.BoolAttribute{
-							MarkdownDescription: "Whether only builds performed on the branch mapped from the environment are " +
-								"tracked or not. Basically if you only want Sleuth to find builds that were triggered" +
-								"by a change on the branch that is configured for the environment, set this to false. " +
-								"Defaults to true",
-							Optional: true,
-							Computed: true,
-							Default:  booldefault.StaticBool(true),
-						},
-						"is_custom": schema.BoolAttribute{
-							MarkdownDescription: "Whether this is a custom build mapping or not. This needs to be set to true " +
-								"if a build name or job name isn't visible in Sleuth. Defaults to false",
-							Optional: true,
-							Computed: true,
-							Default:  booldefault.StaticBool(false),
-						},
-					},
+					Attributes: buildMappingAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// repositoryAttributes are the attributes of the repository, the deprecated ones and one block per provider
+func repositoryAttributes() map[string]schema.Attribute {
+	deprecationMessage := "Use one of the provider blocks, such as `github`, instead."
+	attributes := map[string]schema.Attribute{
+		"owner": schema.StringAttribute{
+			MarkdownDescription: "The repository owner, usually the organization or user name. Deprecated, use a provider block instead.",
+			Optional:            true,
+			DeprecationMessage:  deprecationMessage,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The repository name. Deprecated, use a provider block instead.",
+			Optional:            true,
+			DeprecationMessage:  deprecationMessage,
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "The repository URL, used for links. Deprecated, use a provider block instead.",
+			Optional:            true,
+			DeprecationMessage:  deprecationMessage,
+		},
+		"provider": schema.StringAttribute{
+			MarkdownDescription: "The repository provider, options: AZURE, BITBUCKET, CUSTOM_GIT, GITHUB, GITHUB_ENTERPRISE, GITLAB. Deprecated, use a provider block instead.",
+			CustomType:          caseInsensitiveStringType{},
+			Optional:            true,
+			DeprecationMessage:  deprecationMessage,
+			Validators: []validator.String{
+				validators.OneOfCaseInsensitive(repositoryProviders...),
+			},
+		},
+		"integration_slug": schema.StringAttribute{
+			MarkdownDescription: "IntegrationAuthentication slug used, required for Azure DevOps repositories",
+			Optional:            true,
+			Computed:            true,
+		},
+		"repo_uid": schema.StringAttribute{
+			MarkdownDescription: "Repository UID, required only for AZURE provider. Deprecated, use `azure.repo_uid` instead.",
+			Optional:            true,
+			DeprecationMessage:  deprecationMessage,
+		},
+		"project_uid": schema.StringAttribute{
+			MarkdownDescription: "Project UID, required only for AZURE provider. Deprecated, use `azure.project_uid` instead.",
+			Optional:            true,
+			DeprecationMessage:  deprecationMessage,
+		},
+		"webhook": schema.SingleNestedAttribute{
+			MarkdownDescription: "Webhook configuration for registering deploys from code integrations in read-only mode",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					MarkdownDescription: "Webhook URL",
+					Computed:            true,
+				},
+				"secret": schema.StringAttribute{
+					MarkdownDescription: "Webhook secret to present in payloads sent to the webhook URL",
+					Computed:            true,
+					Sensitive:           true,
+				},
+			},
+		},
+	}
+
+	for _, block := range repositoryBlocks {
+		blockAttributes := map[string]schema.Attribute{
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The repository owner, usually the organization, group, workspace or user name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The repository name",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The repository URL, used for links",
+				Required:            true,
+			},
+		}
+		if block.baseURL != "" {
+			blockAttributes["url"] = schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The repository URL, used for links. Defaults to `%s/<owner>/<name>`.", block.baseURL),
+				Optional:            true,
+			}
+		}
+		if block.azure {
+			blockAttributes["owner"] = schema.StringAttribute{
+				MarkdownDescription: "The Azure DevOps organization",
+				Required:            true,
+			}
+			blockAttributes["project_uid"] = schema.StringAttribute{
+				MarkdownDescription: "Project UID. You can obtain it from the [API](https://learn.microsoft.com/en-us/rest/api/azure/devops/git/repositories/list?view=azure-devops-rest-6.0&tabs=HTTP)",
+				Required:            true,
+			}
+			blockAttributes["repo_uid"] = schema.StringAttribute{
+				MarkdownDescription: "Repository UID. You can obtain it from the [API](https://learn.microsoft.com/en-us/rest/api/azure/devops/git/repositories/list?view=azure-devops-rest-6.0&tabs=HTTP)",
+				Required:            true,
+			}
+		}
+		attributes[block.attribute] = schema.SingleNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("A repository hosted on %s", block.title),
+			Optional:            true,
+			Attributes:          blockAttributes,
+		}
+	}
+	return attributes
+}
+
+// environmentMappingAttributes are the attributes of an environment mapping, shared with the version 0 schema
+func environmentMappingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"environment_slug": schema.StringAttribute{
+			MarkdownDescription: "The environment slug for mapping",
+			Required:            true,
+		},
+		"branch": schema.StringAttribute{
+			MarkdownDescription: "The repository branch name for the environment",
+			Required:            true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Computed ID",
+			Computed:            true,
+		},
+	}
+}
+
+// buildMappingAttributes are the attributes of a build mapping, shared with the version 0 schema
+func buildMappingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"environment_slug": schema.StringAttribute{
+			MarkdownDescription: "The environment slug",
+			Required:            true,
+		},
+		"provider": schema.StringAttribute{
+			MarkdownDescription: "The build provider. Options: AZURE, BITBUCKET_PIPELINES, BUILDKITE, CIRCLECI, GITHUB, GITLAB, JENKINS",
+			CustomType:          caseInsensitiveStringType{},
+			Required:            true,
+			Validators: []validator.String{
+				validators.OneOfCaseInsensitive(buildProviders...),
+			},
+		},
+		"integration_slug": schema.StringAttribute{
+			MarkdownDescription: "IntegrationAuthentication slug used",
+			Optional:            true,
+			Computed:            true,
+		},
+		"build_name": schema.StringAttribute{
+			MarkdownDescription: "The remote build or pipeline name",
+			Required:            true,
+		},
+		"job_name": schema.StringAttribute{
+			MarkdownDescription: "The job or stage within the build or pipeline, if supported",
+			Optional:            true,
+		},
+		"project_key": schema.StringAttribute{
+			MarkdownDescription: "The build project key. Conflicts with project_name, when only project_name is set this is the key " +
+				"it resolved to.",
+			Optional: true,
+			Computed: true,
+		},
+		"project_name": schema.StringAttribute{
+			MarkdownDescription: "The build project name, resolved to its project_key by Sleuth. Conflicts with project_key.",
+			Optional:            true,
+		},
+		"match_branch_to_environment": schema.BoolAttribute{
+			MarkdownDescription: "Whether only builds performed on the branch mapped from the environment are " +
+				"tracked or not. Basically if you only want Sleuth to find builds that were triggered" +
+				"by a change on the branch that is configured for the environment, set this to false. " +
+				"Defaults to true",
+			Optional: true,
+			Computed: true,
+		},
+		"is_custom": schema.BoolAttribute{
+			MarkdownDescription: "Whether this is a custom build mapping or not. This needs to be set to true " +
+				"if a build name or job name isn't visible in Sleuth. Defaults to false",
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
 func (ccsr *codeChangeSourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -294,7 +476,7 @@ func (ccsr *codeChangeSourceResource) Create(ctx context.Context, req resource.C
 	ctx = tflog.SetField(ctx, "resource", "CodeChangeSource")
 	ctx = tflog.SetField(ctx, "operation", "create")
 
-	var plan codeChangeResourceModel
+	var plan codeChangeSourceResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	res.Diagnostics.Append(diags...)
 
@@ -306,7 +488,7 @@ func (ccsr *codeChangeSourceResource) Create(ctx context.Context, req resource.C
 	tflog.Info(ctx, "Creating CodeChangeSource resource", map[string]any{"name": plan.Name.ValueString(), "projectSlug": plan.ProjectSlug.ValueString()})
 
 	projectSlug := plan.ProjectSlug.ValueString()
-	inputFields, err := getMutableCodeChangeSourceStruct(plan)
+	inputFields, err := getMutableCodeChangeSourceStruct(plan.codeChangeResourceModel)
 	if err != nil {
 		res.Diagnostics.AddError("Could not create input object", fmt.Sprintf("Could not create input object: %+v", err.Error()))
 		return
@@ -314,9 +496,13 @@ func (ccsr *codeChangeSourceResource) Create(ctx context.Context, req resource.C
 
 	input := gqlclient.CreateCodeChangeSourceMutationInput{
 		ProjectSlug:             projectSlug,
-		InitializeChanges:       true,
+		InitializeChanges:       plan.InitializeChanges.ValueBool(),
 		MutableCodeChangeSource: inputFields,
 	}
+	if !plan.InitialHistoryDays.IsNull() {
+		initialHistoryDays := int(plan.InitialHistoryDays.ValueInt64())
+		input.InitialHistoryDays = &initialHistoryDays
+	}
 
 	if err := validateCodeChangeInput(*inputFields); err != nil {
 		tflog.Error(ctx, "Error validating CodeChangeSource input", map[string]any{"err": err.Error()})
@@ -328,14 +514,14 @@ func (ccsr *codeChangeSourceResource) Create(ctx context.Context, req resource.C
 	ccs, err := ccsr.c.CreateCodeChangeSource(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error creating CodeChangeSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error creating CodeChangeSource",
-			fmt.Sprintf("Could not create code change source, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating CodeChangeSource", fmt.Sprintf("Could not create code change source, unexpected error: %+v", err.Error()), err, codeChangeSourceFieldAliases)
 		return
 	}
 
-	state, diags := getNewStateFromCodeChangeSource(ctx, ccs, projectSlug, plan)
+	if plan.IgnoreExternalMappings.ValueBool() {
+		ccs = withoutExternalMappings(ccs, plan.codeChangeResourceModel)
+	}
+	state, diags := getNewStateFromCodeChangeSource(ctx, ccs, projectSlug, plan.codeChangeResourceModel)
 
 	// if they are both empty, make sure they match (could be [] or nil)
 	// if len(plan.BuildMappings) < 1 && len(state.BuildMappings) < 1 {
@@ -344,7 +530,8 @@ func (ccsr *codeChangeSourceResource) Create(ctx context.Context, req resource.C
 
 	res.Diagnostics.Append(diags...)
 
-	diags = res.State.Set(ctx, state)
+	plan.codeChangeResourceModel = state
+	diags = res.State.Set(ctx, plan)
 	res.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "Successfully created CodeChangeSource", map[string]any{"diags": res.Diagnostics})
 }
@@ -353,7 +540,7 @@ func (ccsr *codeChangeSourceResource) Read(ctx context.Context, req resource.Rea
 	ctx = tflog.SetField(ctx, "resource", "code_change_source")
 	ctx = tflog.SetField(ctx, "operation", "read")
 
-	var state codeChangeResourceModel
+	var state codeChangeSourceResourceModel
 	diags := req.State.Get(ctx, &state)
 	res.Diagnostics.Append(diags...)
 
@@ -365,20 +552,13 @@ func (ccsr *codeChangeSourceResource) Read(ctx context.Context, req resource.Rea
 	tflog.Info(ctx, "Reading CodeChangeSource resource", map[string]any{"state": state})
 	projectSlug := state.ProjectSlug.ValueString()
 	slug := state.Slug.ValueString()
-	// when importing a resource, only ID will be set with project slug & slug
-	if projectSlug == "" {
-		id := state.ID.ValueString()
-		splits := strings.Split(id, "/")
-		if len(splits) != 2 {
-			res.Diagnostics.AddError("Error importing CodeChangeSource", "Imported code change source must have an ID of the form 'project_slug/change_source_slug'")
-			return
-		}
-
-		projectSlug = splits[0]
-		slug = splits[1]
-	}
 
 	ccs, err := ccsr.c.GetCodeChangeSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "CodeChangeSource not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		tflog.Error(ctx, "Error reading CodeChangeSource", map[string]any{"error": err.Error()})
 		res.Diagnostics.AddError(
@@ -387,10 +567,14 @@ func (ccsr *codeChangeSourceResource) Read(ctx context.Context, req resource.Rea
 		)
 		return
 	}
-	newState, diags := getNewStateFromCodeChangeSource(ctx, ccs, projectSlug, state)
+	if state.IgnoreExternalMappings.ValueBool() {
+		ccs = withoutExternalMappings(ccs, state.codeChangeResourceModel)
+	}
+	newState, diags := getNewStateFromCodeChangeSource(ctx, ccs, projectSlug, state.codeChangeResourceModel)
 	res.Diagnostics.Append(diags...)
 
-	diags = res.State.Set(ctx, newState)
+	state.codeChangeResourceModel = newState
+	diags = res.State.Set(ctx, state.withDefaults())
 	res.Diagnostics.Append(diags...)
 
 }
@@ -399,12 +583,12 @@ func (ccsr *codeChangeSourceResource) Update(ctx context.Context, req resource.U
 	ctx = tflog.SetField(ctx, "resource", "code_change_source")
 	ctx = tflog.SetField(ctx, "operation", "update")
 
-	var state codeChangeResourceModel
+	var state codeChangeSourceResourceModel
 	diags := req.State.Get(ctx, &state)
 
 	res.Diagnostics.Append(diags...)
 
-	var plan codeChangeResourceModel
+	var plan codeChangeSourceResourceModel
 	diags = req.Plan.Get(ctx, &plan)
 	res.Diagnostics.Append(diags...)
 
@@ -417,7 +601,12 @@ func (ccsr *codeChangeSourceResource) Update(ctx context.Context, req resource.U
 	}
 
 	projectSlug := plan.ProjectSlug.ValueString()
-	inputFields, err := getMutableCodeChangeSourceStruct(plan)
+	if plan.IgnoreExternalMappings.ValueBool() {
+		ccsr.updateKeepingExternalMappings(ctx, state, plan, res)
+		return
+	}
+
+	inputFields, err := getMutableCodeChangeSourceStruct(plan.codeChangeResourceModel)
 	if err != nil {
 		res.Diagnostics.AddError("Could not create input object", fmt.Sprintf("Could not create input object: %+v", err.Error()))
 		return
@@ -439,28 +628,55 @@ func (ccsr *codeChangeSourceResource) Update(ctx context.Context, req resource.U
 	ccs, err := ccsr.c.UpdateCodeChangeSource(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error updating CodeChangeSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error updating CodeChangeSource",
-			fmt.Sprintf("Could not update code change soure, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating CodeChangeSource", fmt.Sprintf("Could not update code change soure, unexpected error: %+v", err.Error()), err, codeChangeSourceFieldAliases)
 		return
 	}
 
-	newState, diags := getNewStateFromCodeChangeSource(ctx, ccs, projectSlug, plan)
+	newState, diags := getNewStateFromCodeChangeSource(ctx, ccs, projectSlug, plan.codeChangeResourceModel)
 
 	res.Diagnostics.Append(diags...)
 
-	diags = res.State.Set(ctx, newState)
+	plan.codeChangeResourceModel = newState
+	diags = res.State.Set(ctx, plan)
 	res.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "Successfully created CodeChangeSource", map[string]any{"diags": res.Diagnostics})
 
 }
 
+// updateKeepingExternalMappings updates a source that ignores external mappings. Mappings neither in the prior state
+// nor in the plan were added outside of the resource, so they are read right before the update and saved along.
+func (ccsr *codeChangeSourceResource) updateKeepingExternalMappings(ctx context.Context, state, plan codeChangeSourceResourceModel, res *resource.UpdateResponse) {
+	projectSlug := plan.ProjectSlug.ValueString()
+	ccs, err := ccsr.c.UpdateCodeChangeSourceMappings(ctx, projectSlug, state.Slug.ValueString(), func(current *gqlclient.MutableCodeChangeSource) error {
+		input, err := getMutableCodeChangeSourceStructWithExternalMappings(plan.codeChangeResourceModel, current, state.codeChangeResourceModel)
+		if err != nil {
+			return err
+		}
+		if err := validateCodeChangeInput(*input); err != nil {
+			return err
+		}
+		*current = *input
+		return nil
+	})
+	if err != nil {
+		tflog.Error(ctx, "Error updating CodeChangeSource", map[string]any{"error": err.Error()})
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating CodeChangeSource", fmt.Sprintf("Could not update code change source, unexpected error: %+v", err.Error()), err, codeChangeSourceFieldAliases)
+		return
+	}
+
+	newState, diags := getNewStateFromCodeChangeSource(ctx, withoutExternalMappings(ccs, plan.codeChangeResourceModel), projectSlug, plan.codeChangeResourceModel)
+	res.Diagnostics.Append(diags...)
+
+	plan.codeChangeResourceModel = newState
+	diags = res.State.Set(ctx, plan)
+	res.Diagnostics.Append(diags...)
+}
+
 func (ccsr *codeChangeSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
 	ctx = tflog.SetField(ctx, "resource", "code_change_source")
 	ctx = tflog.SetField(ctx, "operation", "delete")
 
-	var state codeChangeResourceModel
+	var state codeChangeSourceResourceModel
 	diags := req.State.Get(ctx, &state)
 	res.Diagnostics.Append(diags...)
 
@@ -480,7 +696,414 @@ func (ccsr *codeChangeSourceResource) Delete(ctx context.Context, req resource.D
 }
 
 func (ccsr *codeChangeSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	// code change sources are imported by `project_slug/slug` or `project_slug/name:<Name>`
+	importProjectScopedResource(ctx, req, res, "CodeChangeSource", func(ctx context.Context, projectSlug, name string) (string, error) {
+		ccs, err := ccsr.c.GetCodeChangeSourceByName(ctx, &projectSlug, &name)
+		if err != nil {
+			return "", err
+		}
+		return ccs.Slug, nil
+	})
+}
+
+func (ccsr *codeChangeSourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	validatePathFiltersConfig(ctx, req, res)
+	validateMappingsConfig(ctx, req, res)
+	validateBuildMappingsConfig(ctx, req, res)
+	validateInitializationConfig(ctx, req, res)
+	validateRepositoryConfig(ctx, req, res)
+}
+
+// validateInitializationConfig validates that `initial_history_days` is positive and only set when history is imported
+func validateInitializationConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var initializeChanges types.Bool
+	var initialHistoryDays types.Int64
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initialize_changes"), &initializeChanges)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_history_days"), &initialHistoryDays)...)
+	if res.Diagnostics.HasError() || initialHistoryDays.IsNull() || initialHistoryDays.IsUnknown() {
+		return
+	}
+
+	if initialHistoryDays.ValueInt64() < 1 {
+		res.Diagnostics.AddAttributeError(
+			path.Root("initial_history_days"),
+			"Invalid initial_history_days",
+			fmt.Sprintf("initial_history_days must be at least 1, got %d", initialHistoryDays.ValueInt64()),
+		)
+	}
+	if !initializeChanges.IsNull() && !initializeChanges.IsUnknown() && !initializeChanges.ValueBool() {
+		res.Diagnostics.AddAttributeError(
+			path.Root("initial_history_days"),
+			"Conflicting initialization",
+			"initial_history_days only applies when initialize_changes is true, remove it to only track changes from now on",
+		)
+	}
+}
+
+// validatePathFiltersConfig validates the `path_filters` globs, and that a `path_prefix` set along with them describes
+// the same filter, as it does in configuration generated for an imported source.
+func validatePathFiltersConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var pathFilters types.Object
+	var pathPrefix pathPrefixValue
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("path_filters"), &pathFilters)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("path_prefix"), &pathPrefix)...)
+	if res.Diagnostics.HasError() || pathFilters.IsNull() || pathFilters.IsUnknown() {
+		return
+	}
+
+	var model pathFiltersResourceModel
+	res.Diagnostics.Append(pathFilters.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	for _, globs := range []struct {
+		name  string
+		value types.List
+	}{{"include", model.Include}, {"exclude", model.Exclude}} {
+		for i, glob := range globs.value.Elements() {
+			globValue, ok := glob.(types.String)
+			if !ok || globValue.IsUnknown() {
+				continue
+			}
+			attributePath := path.Root("path_filters").AtName(globs.name).AtListIndex(i)
+			if globValue.IsNull() {
+				res.Diagnostics.AddAttributeError(attributePath, "Invalid path filter", "path must not be null")
+				continue
+			}
+			if err := validatePathGlob(globValue.ValueString()); err != nil {
+				res.Diagnostics.AddAttributeError(attributePath, "Invalid path filter", err.Error())
+			}
+		}
+	}
+
+	if pathPrefix.IsNull() || pathPrefix.IsUnknown() || !isFullyKnown(ctx, pathFilters) || res.Diagnostics.HasError() {
+		return
+	}
+	filter, diags := pathFiltersToPathFilter(ctx, pathFilters)
+	res.Diagnostics.Append(diags...)
+	encoded, err := encodePathFilter(filter)
+	if res.Diagnostics.HasError() || err != nil {
+		return
+	}
+	if !jsonSemanticallyEqual(encoded, pathPrefix.ValueString()) {
+		res.Diagnostics.AddAttributeError(
+			path.Root("path_prefix"),
+			"Conflicting path filters",
+			"path_prefix is a deprecated alias of path_filters and describes a different filter, remove path_prefix",
+		)
+	}
+}
+
+// validateMappingsConfig rejects environment mappings for the same environment, and build mappings for the same
+// environment and build name, which the set of mappings does not deduplicate as they differ in other attributes.
+func validateMappingsConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var environmentMappings []environmentMappingsResourceModel
+	var buildMappings []buildMappingsResourceModel
+	res.Diagnostics.Append(getKnownSetAttribute(ctx, req.Config, path.Root("environment_mappings"), &environmentMappings)...)
+	res.Diagnostics.Append(getKnownSetAttribute(ctx, req.Config, path.Root("build_mappings"), &buildMappings)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	environments := map[string]bool{}
+	for _, em := range environmentMappings {
+		if em.EnvironmentSlug.IsUnknown() {
+			continue
+		}
+		if environments[em.EnvironmentSlug.ValueString()] {
+			res.Diagnostics.AddAttributeError(
+				path.Root("environment_mappings"),
+				"Duplicate environment mapping",
+				fmt.Sprintf("Environment %s is mapped more than once", em.EnvironmentSlug.ValueString()),
+			)
+		}
+		environments[em.EnvironmentSlug.ValueString()] = true
+	}
+
+	builds := map[string]bool{}
+	for _, bm := range buildMappings {
+		if bm.EnvironmentSlug.IsUnknown() || bm.BuildName.IsUnknown() {
+			continue
+		}
+		key := buildMappingKey(bm.EnvironmentSlug.ValueString(), bm.BuildName.ValueString())
+		if builds[key] {
+			res.Diagnostics.AddAttributeError(
+				path.Root("build_mappings"),
+				"Duplicate build mapping",
+				fmt.Sprintf("Build %s is mapped to environment %s more than once", bm.BuildName.ValueString(), bm.EnvironmentSlug.ValueString()),
+			)
+		}
+		builds[key] = true
+	}
+}
+
+// validateBuildMappingsConfig validates that build mappings set either project_key or project_name, that a source
+// tracking deploys by builds has them, and that their environments are mapped. The latter two are left to the API when
+// mappings managed outside of the resource are kept, as those may provide the builds and environments.
+func validateBuildMappingsConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var deployTrackingType caseInsensitiveStringValue
+	var ignoreExternalMappings types.Bool
+	var environmentMappingsSet, buildMappingsSet types.Set
+	var environmentMappings []environmentMappingsResourceModel
+	var buildMappings []buildMappingsResourceModel
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deploy_tracking_type"), &deployTrackingType)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ignore_external_mappings"), &ignoreExternalMappings)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environment_mappings"), &environmentMappingsSet)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("build_mappings"), &buildMappingsSet)...)
+	res.Diagnostics.Append(getKnownSetAttribute(ctx, req.Config, path.Root("environment_mappings"), &environmentMappings)...)
+	res.Diagnostics.Append(getKnownSetAttribute(ctx, req.Config, path.Root("build_mappings"), &buildMappings)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	for _, bm := range buildMappings {
+		if isSet(bm.ProjectKey) && isSet(bm.ProjectName) {
+			res.Diagnostics.AddAttributeError(
+				path.Root("build_mappings"),
+				"Conflicting build project",
+				fmt.Sprintf("Build %s of environment %s sets both project_key and project_name, set only one of them", bm.BuildName.ValueString(), bm.EnvironmentSlug.ValueString()),
+			)
+		}
+	}
+
+	if ignoreExternalMappings.IsUnknown() || ignoreExternalMappings.ValueBool() {
+		return
+	}
+	if !deployTrackingType.IsUnknown() && strings.EqualFold(deployTrackingType.ValueString(), "build") && !buildMappingsSet.IsUnknown() && len(buildMappingsSet.Elements()) == 0 {
+		res.Diagnostics.AddAttributeError(
+			path.Root("build_mappings"),
+			"Missing build mappings",
+			"build_mappings is required when deploy_tracking_type is build",
+		)
+	}
+	if !isFullyKnown(ctx, environmentMappingsSet) {
+		return
+	}
+	environments := map[string]bool{}
+	for _, em := range environmentMappings {
+		environments[em.EnvironmentSlug.ValueString()] = true
+	}
+	for _, bm := range buildMappings {
+		if bm.EnvironmentSlug.IsUnknown() || environments[bm.EnvironmentSlug.ValueString()] {
+			continue
+		}
+		res.Diagnostics.AddAttributeError(
+			path.Root("build_mappings"),
+			"Unmapped build environment",
+			fmt.Sprintf("Build %s is mapped to environment %s, which has no environment_mappings entry for its branch", bm.BuildName.ValueString(), bm.EnvironmentSlug.ValueString()),
+		)
+	}
+}
+
+// isSet reports whether value is known and not null
+func isSet(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+func (ccsr *codeChangeSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	modifyPathFiltersPlan(ctx, req, res)
+	modifyMappingsPlan(ctx, req, res)
+	modifyInitializationPlan(ctx, req, res)
+}
+
+// modifyInitializationPlan keeps initialize_changes unset on imported and upgraded sources while it is not configured,
+// instead of planning an update to its default that does nothing
+func modifyInitializationPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var configured types.Bool
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initialize_changes"), &configured)...)
+	created, diags := createdWithInitialization(ctx, req.State)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() || created || !configured.IsNull() {
+		return
+	}
+	res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("initialize_changes"), types.BoolNull())...)
+}
+
+// modifyMappingsPlan plans environment and build mappings from their configuration, with defaults and computed
+// attributes filled in. Set elements have no stable path, so defaults and plan modifiers of their attributes can not
+// find the configured value, and prior build mappings are matched by environment slug and build name instead.
+func modifyMappingsPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	var projectSlug types.String
+	var environmentMappings []environmentMappingsResourceModel
+	var buildMappings, priorBuildMappings []buildMappingsResourceModel
+	res.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_slug"), &projectSlug)...)
+	res.Diagnostics.Append(getKnownSetAttribute(ctx, req.Config, path.Root("environment_mappings"), &environmentMappings)...)
+	res.Diagnostics.Append(getKnownSetAttribute(ctx, req.Config, path.Root("build_mappings"), &buildMappings)...)
+	if !req.State.Raw.IsNull() {
+		res.Diagnostics.Append(getKnownSetAttribute(ctx, req.State, path.Root("build_mappings"), &priorBuildMappings)...)
+	}
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if environmentMappings != nil {
+		for i, em := range environmentMappings {
+			environmentMappings[i].ID = types.StringUnknown()
+			if !projectSlug.IsUnknown() && !em.EnvironmentSlug.IsUnknown() {
+				environmentMappings[i].ID = types.StringValue(fmt.Sprintf("%s/%s", projectSlug.ValueString(), em.EnvironmentSlug.ValueString()))
+			}
+		}
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("environment_mappings"), environmentMappings)...)
+	}
+
+	if buildMappings != nil {
+		priorBuildMappingLookup := map[string]buildMappingsResourceModel{}
+		for _, pbm := range priorBuildMappings {
+			priorBuildMappingLookup[buildMappingKey(pbm.EnvironmentSlug.ValueString(), pbm.BuildName.ValueString())] = pbm
+		}
+		for i, bm := range buildMappings {
+			pbm, hasPrior := priorBuildMappingLookup[buildMappingKey(bm.EnvironmentSlug.ValueString(), bm.BuildName.ValueString())]
+			hasPrior = hasPrior && !bm.EnvironmentSlug.IsUnknown() && !bm.BuildName.IsUnknown()
+
+			if bm.IntegrationSlug.IsNull() {
+				buildMappings[i].IntegrationSlug = types.StringUnknown()
+				if hasPrior {
+					buildMappings[i].IntegrationSlug = pbm.IntegrationSlug
+				}
+			}
+			// the key project_name resolves to is only known after apply if project_name changed
+			if bm.ProjectKey.IsNull() {
+				buildMappings[i].ProjectKey = types.StringUnknown()
+				if hasPrior && bm.ProjectName.Equal(pbm.ProjectName) {
+					buildMappings[i].ProjectKey = pbm.ProjectKey
+				}
+			}
+			if bm.MatchBranchToEnvironment.IsNull() {
+				buildMappings[i].MatchBranchToEnvironment = types.BoolValue(true)
+			}
+			if bm.IsCustom.IsNull() {
+				buildMappings[i].IsCustom = types.BoolValue(false)
+			}
+		}
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("build_mappings"), buildMappings)...)
+	}
+}
+
+// getKnownSetAttribute reads the set at attributePath into target, leaving it nil if the set or one of its elements is
+// null or unknown
+func getKnownSetAttribute(ctx context.Context, data interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, attributePath path.Path, target interface{}) diag.Diagnostics {
+	var set types.Set
+	diags := data.GetAttribute(ctx, attributePath, &set)
+	if diags.HasError() || set.IsNull() || set.IsUnknown() {
+		return diags
+	}
+	for _, element := range set.Elements() {
+		if element.IsUnknown() {
+			return diags
+		}
+	}
+	diags.Append(set.ElementsAs(ctx, target, false)...)
+	return diags
+}
+
+func buildMappingKey(environmentSlug, buildName string) string {
+	return environmentSlug + ":" + buildName
+}
+
+// modifyPathFiltersPlan plans whichever of `path_prefix` and `path_filters` is not configured from the other one
+func modifyPathFiltersPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	var configPathFilters, statePathFilters types.Object
+	var configPathPrefix, statePathPrefix pathPrefixValue
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("path_filters"), &configPathFilters)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("path_prefix"), &configPathPrefix)...)
+	statePathFilters = types.ObjectNull(pathFiltersResourceModel{}.AttributeTypes())
+	statePathPrefix = pathPrefixValue{StringValue: types.StringNull()}
+	if !req.State.Raw.IsNull() {
+		res.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("path_filters"), &statePathFilters)...)
+		res.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("path_prefix"), &statePathPrefix)...)
+	}
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !configPathFilters.IsNull() && !configPathPrefix.IsNull():
+		return
+	case !configPathFilters.IsNull():
+		pathPrefix := pathPrefixValue{StringValue: types.StringUnknown()}
+		if isFullyKnown(ctx, configPathFilters) {
+			filter, diags := pathFiltersToPathFilter(ctx, configPathFilters)
+			res.Diagnostics.Append(diags...)
+			encoded, err := encodePathFilter(filter)
+			if err != nil {
+				res.Diagnostics.AddAttributeError(path.Root("path_filters"), "Could not encode path filters", err.Error())
+			}
+			if res.Diagnostics.HasError() {
+				return
+			}
+			pathPrefix = newPathPrefixValue(encoded)
+			if !statePathPrefix.IsNull() && jsonSemanticallyEqual(statePathPrefix.ValueString(), encoded) {
+				pathPrefix = statePathPrefix
+			}
+		}
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("path_prefix"), pathPrefix)...)
+		// include and exclude are only computed from path_prefix, unset ones are planned null instead of unknown
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("path_filters"), configPathFilters)...)
+	case !configPathPrefix.IsNull():
+		pathFilters := types.ObjectUnknown(pathFiltersResourceModel{}.AttributeTypes())
+		if !configPathPrefix.IsUnknown() {
+			var diags diag.Diagnostics
+			pathFilters, diags = getPathFiltersValue(ctx, configPathPrefix.ValueString(), statePathFilters)
+			res.Diagnostics.Append(diags...)
+		}
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("path_filters"), pathFilters)...)
+	default:
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("path_prefix"), newPathPrefixValue(""))...)
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("path_filters"), types.ObjectNull(pathFiltersResourceModel{}.AttributeTypes()))...)
+	}
+}
+
+// isFullyKnown reports whether value and everything nested in it is known
+func isFullyKnown(ctx context.Context, value attr.Value) bool {
+	tfValue, err := value.ToTerraformValue(ctx)
+	return err == nil && tfValue.IsFullyKnown()
+}
+
+// UpgradeState migrates states of version 0, which had environment_mappings and build_mappings as lists. Both hold
+// the same objects as the sets that replaced them, so the state is read with the version 0 schema and written back.
+func (ccsr *codeChangeSourceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var current resource.SchemaResponse
+	ccsr.Schema(ctx, resource.SchemaRequest{}, &current)
+
+	priorSchema := current.Schema
+	priorSchema.Version = 0
+	priorSchema.Attributes = maps.Clone(current.Schema.Attributes)
+	for _, name := range []string{"ignore_external_mappings", "initialize_changes", "initial_history_days"} {
+		delete(priorSchema.Attributes, name)
+	}
+	priorSchema.Attributes["environment_mappings"] = schema.ListNestedAttribute{
+		Optional:     true,
+		NestedObject: schema.NestedAttributeObject{Attributes: environmentMappingAttributes()},
+	}
+	priorSchema.Attributes["build_mappings"] = schema.ListNestedAttribute{
+		Optional:     true,
+		NestedObject: schema.NestedAttributeObject{Attributes: buildMappingAttributes()},
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &priorSchema,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, res *resource.UpgradeStateResponse) {
+				var state codeChangeResourceModel
+				res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if res.Diagnostics.HasError() {
+					return
+				}
+				res.Diagnostics.Append(res.State.Set(ctx, codeChangeSourceResourceModel{
+					codeChangeResourceModel: state,
+					InitialHistoryDays:      types.Int64Null(),
+				}.withDefaults())...)
+			},
+		},
+	}
 }
 
 func validateCodeChangeInput(ccs gqlclient.MutableCodeChangeSource) error {
@@ -509,56 +1132,24 @@ func getNewStateFromCodeChangeSource(ctx context.Context, ccs *gqlclient.CodeCha
 	}
 
 	var buildMappings []buildMappingsResourceModel = []buildMappingsResourceModel{}
-	// Build a lookup from plan for project_name preservation
-	planBuildMappingLookup := map[string]buildMappingsResourceModel{}
-	for _, pbm := range plan.BuildMappings {
-		key := pbm.EnvironmentSlug.ValueString() + ":" + pbm.BuildName.ValueString()
-		planBuildMappingLookup[key] = pbm
+	planBuildMappingOrder := map[string]int{}
+	for idx, pbm := range plan.BuildMappings {
+		planBuildMappingOrder[buildMappingKey(pbm.EnvironmentSlug.ValueString(), pbm.BuildName.ValueString())] = idx
 	}
-	for _, bm := range ccs.DeployTrackingBuildMappings {
-		key := bm.Environment.Slug + ":" + bm.BuildName
-		planBM, hasPlan := planBuildMappingLookup[key]
-		projectName := ""
-		if hasPlan {
-			projectName = planBM.ProjectName.ValueString()
-		}
-		// Find the original provider from the plan for this build mapping
-		originalProvider := strings.ToUpper(bm.Provider) // fallback to uppercase API response
-		for _, planBM := range plan.BuildMappings {
-			if planBM.EnvironmentSlug.ValueString() == bm.Environment.Slug && planBM.BuildName.ValueString() == bm.BuildName {
-				originalProvider = planBM.Provider.ValueString()
-				break
-			}
-		}
-		buildMappingObj := buildMappingsResourceModel{
-			EnvironmentSlug:          types.StringValue(bm.Environment.Slug),
-			Provider:                 types.StringValue(originalProvider),
-			IntegrationSlug:          types.StringValue(bm.IntegrationSlug),
-			BuildName:                types.StringValue(bm.BuildName),
-			JobName:                  types.StringValue(bm.JobName),
-			ProjectKey:               types.StringNull(),
-			ProjectName:              types.StringNull(),
-			MatchBranchToEnvironment: types.BoolValue(bm.MatchBranchToEnvironment),
-			IsCustom:                 types.BoolValue(bm.IsCustom),
-		}
-
-		if projectName != "" {
-			buildMappingObj.ProjectName = types.StringValue(projectName)
-		}
-
-		if bm.IntegrationSlug == "" {
-			buildMappingObj.IntegrationSlug = types.StringNull()
-		}
-
-		if bm.JobName == "" {
-			buildMappingObj.JobName = types.StringNull()
+	// The mappings are listed in the order of the plan, as the semantic equality keeping the configured case of their
+	// provider compares set elements by index
+	position := func(bm gqlclient.DeployTrackingBuildMapping) int {
+		if idx, found := planBuildMappingOrder[buildMappingKey(bm.Environment.Slug, bm.BuildName)]; found {
+			return idx
 		}
-
-		if hasPlan && !planBM.ProjectKey.IsNull() && bm.BuildProjectKey != "" {
-			buildMappingObj.ProjectKey = types.StringValue(bm.BuildProjectKey)
-		}
-
-		buildMappings = append(buildMappings, buildMappingObj)
+		return len(plan.BuildMappings)
+	}
+	sortedBuildMappings := slices.Clone(ccs.DeployTrackingBuildMappings)
+	slices.SortStableFunc(sortedBuildMappings, func(a, b gqlclient.DeployTrackingBuildMapping) int {
+		return position(a) - position(b)
+	})
+	for _, bm := range sortedBuildMappings {
+		buildMappings = append(buildMappings, getBuildMappingState(bm))
 	}
 
 	if len(buildMappings) < 1 && len(plan.BuildMappings) < 1 {
@@ -566,15 +1157,10 @@ func getNewStateFromCodeChangeSource(ctx context.Context, ccs *gqlclient.CodeCha
 	}
 
 	r := repositoryResourceModel{
-		Owner:           types.StringValue(ccs.Repository.Owner),
-		Name:            types.StringValue(ccs.Repository.Name),
-		URL:             types.StringValue(ccs.Repository.Url),
-		Provider:        types.StringValue(plan.Repository.Provider.ValueString()), // preserve original case
 		IntegrationSlug: types.StringNull(),
-		RepoUID:         types.StringNull(),
-		ProjectUID:      types.StringNull(),
 		Webhook:         types.ObjectNull(webhookResourceModel{}.AttributeTypes()),
 	}
+	diags.Append(setRepositoryState(&r, ccs.Repository.RepositoryBase, plan.Repository)...)
 
 	if ccs.Repository.IntegrationAuth != nil {
 		r.IntegrationSlug = types.StringValue(ccs.Repository.IntegrationAuth.Slug)
@@ -591,10 +1177,8 @@ func getNewStateFromCodeChangeSource(ctx context.Context, ccs *gqlclient.CodeCha
 		diags.Append(webhookDiags...)
 	}
 
-	if strings.ToLower(ccs.Repository.Provider) == azureProvider {
-		r.RepoUID = types.StringValue(ccs.Repository.RepoUID)
-		r.ProjectUID = types.StringValue(ccs.Repository.ProjectUID)
-	}
+	pathFilters, pathFiltersDiags := getPathFiltersValue(ctx, ccs.PathPrefix, plan.PathFilters)
+	diags.Append(pathFiltersDiags...)
 
 	return codeChangeResourceModel{
 		ProjectSlug:         types.StringValue(projectSlug),
@@ -604,15 +1188,49 @@ func getNewStateFromCodeChangeSource(ctx context.Context, ccs *gqlclient.CodeCha
 		Repository:          &r,
 		EnvironmentMappings: environmentMappings,
 		BuildMappings:       buildMappings,
-		DeployTrackingType:  types.StringValue(ccs.DeployTrackingType),
+		DeployTrackingType:  newCaseInsensitiveStringValue(ccs.DeployTrackingType),
 		CollectImpact:       types.BoolValue(ccs.CollectImpact),
-		PathPrefix:          types.StringValue(ccs.PathPrefix),
+		PathPrefix:          newPathPrefixValue(ccs.PathPrefix),
+		PathFilters:         pathFilters,
 		NotifyInSlack:       types.BoolValue(ccs.NotifyInSlack),
 		IncludeInDashboard:  types.BoolValue(ccs.IncludeInDashboard),
 		AutoTrackingDelay:   types.Int64Value(int64(ccs.AutoTrackingDelay)),
 	}, diags
 }
 
+// getBuildMappingState returns the state of a build mapping returned by the API
+func getBuildMappingState(bm gqlclient.DeployTrackingBuildMapping) buildMappingsResourceModel {
+	buildMappingObj := buildMappingsResourceModel{
+		EnvironmentSlug:          types.StringValue(bm.Environment.Slug),
+		Provider:                 newCaseInsensitiveStringValue(bm.Provider),
+		IntegrationSlug:          types.StringValue(bm.IntegrationSlug),
+		BuildName:                types.StringValue(bm.BuildName),
+		JobName:                  types.StringValue(bm.JobName),
+		ProjectKey:               types.StringValue(bm.BuildProjectKey),
+		ProjectName:              types.StringValue(bm.BuildProjectName),
+		MatchBranchToEnvironment: types.BoolValue(bm.MatchBranchToEnvironment),
+		IsCustom:                 types.BoolValue(bm.IsCustom),
+	}
+
+	if bm.IntegrationSlug == "" {
+		buildMappingObj.IntegrationSlug = types.StringNull()
+	}
+
+	if bm.JobName == "" {
+		buildMappingObj.JobName = types.StringNull()
+	}
+
+	if bm.BuildProjectKey == "" {
+		buildMappingObj.ProjectKey = types.StringNull()
+	}
+
+	if bm.BuildProjectName == "" {
+		buildMappingObj.ProjectName = types.StringNull()
+	}
+
+	return buildMappingObj
+}
+
 func getMutableCodeChangeSourceStruct(plan codeChangeResourceModel) (*gqlclient.MutableCodeChangeSource, error) {
 	var environmentMappings []gqlclient.BranchMapping
 	environmentMappingsLookup := map[string]string{}
@@ -634,41 +1252,13 @@ func getMutableCodeChangeSourceStruct(plan codeChangeResourceModel) (*gqlclient.
 			return nil, fmt.Errorf("could not find branch for build mapping for environment slug: %s. Did you forget to include this or all environments in the `environment_mappings` field?", environmentSlug)
 		}
 
-		projectKey := bm.ProjectKey.ValueString()
-		projectName := bm.ProjectName.ValueString()
-
-		buildMapping := gqlclient.BuildMapping{
-			EnvironmentSlug:          environmentSlug,
-			Provider:                 strings.ToUpper(bm.Provider.ValueString()),
-			BuildName:                bm.BuildName.ValueString(),
-			JobName:                  bm.JobName.ValueString(),
-			IntegrationSlug:          bm.IntegrationSlug.ValueString(),
-			BuildBranch:              buildBranch,
-			MatchBranchToEnvironment: bm.MatchBranchToEnvironment.ValueBool(),
-			IsCustom:                 bm.IsCustom.ValueBool(),
-		}
-
-		if projectKey != "" {
-			buildMapping.BuildProjectKey = projectKey
-		}
-		if projectName != "" {
-			buildMapping.BuildProjectName = projectName
-		}
-
-		buildMappingsT = append(buildMappingsT, buildMapping)
+		buildMappingsT = append(buildMappingsT, getBuildMappingInput(bm, buildBranch))
 	}
 
 	return &gqlclient.MutableCodeChangeSource{
 		Name: plan.Name.ValueString(),
 		Repository: gqlclient.MutableRepository{
-			RepositoryBase: gqlclient.RepositoryBase{
-				Owner:      plan.Repository.Owner.ValueString(),
-				Name:       plan.Repository.Name.ValueString(),
-				Provider:   strings.ToUpper(plan.Repository.Provider.ValueString()),
-				Url:        plan.Repository.URL.ValueString(),
-				ProjectUID: plan.Repository.ProjectUID.ValueString(),
-				RepoUID:    plan.Repository.RepoUID.ValueString(),
-			},
+			RepositoryBase:  plan.Repository.input(),
 			IntegrationSlug: plan.Repository.IntegrationSlug.ValueString(),
 		},
 		DeployTrackingType:  plan.DeployTrackingType.ValueString(),
@@ -681,3 +1271,92 @@ func getMutableCodeChangeSourceStruct(plan codeChangeResourceModel) (*gqlclient.
 		BuildMappings:       buildMappingsT,
 	}, nil
 }
+
+// withoutExternalMappings returns a copy of ccs with only the environment and build mappings of managed, for sources
+// that ignore mappings managed outside of the resource
+func withoutExternalMappings(ccs *gqlclient.CodeChangeSource, managed codeChangeResourceModel) *gqlclient.CodeChangeSource {
+	environments, builds := mappingKeys(managed)
+	filtered := *ccs
+	filtered.EnvironmentMappings = nil
+	for _, em := range ccs.EnvironmentMappings {
+		if environments[em.EnvironmentSlug] {
+			filtered.EnvironmentMappings = append(filtered.EnvironmentMappings, em)
+		}
+	}
+	filtered.DeployTrackingBuildMappings = nil
+	for _, bm := range ccs.DeployTrackingBuildMappings {
+		if builds[buildMappingKey(bm.Environment.Slug, bm.BuildName)] {
+			filtered.DeployTrackingBuildMappings = append(filtered.DeployTrackingBuildMappings, bm)
+		}
+	}
+	return &filtered
+}
+
+// getMutableCodeChangeSourceStructWithExternalMappings returns the input for plan with the mappings of current that
+// are in neither the plan nor the prior state added, so build mappings of the plan may use an external environment
+// mapping
+func getMutableCodeChangeSourceStructWithExternalMappings(plan codeChangeResourceModel, current *gqlclient.MutableCodeChangeSource, prior codeChangeResourceModel) (*gqlclient.MutableCodeChangeSource, error) {
+	priorEnvironments, priorBuilds := mappingKeys(prior)
+	plannedEnvironments, plannedBuilds := mappingKeys(plan)
+
+	merged := plan
+	merged.EnvironmentMappings = slices.Clone(plan.EnvironmentMappings)
+	for _, em := range current.EnvironmentMappings {
+		if !priorEnvironments[em.EnvironmentSlug] && !plannedEnvironments[em.EnvironmentSlug] {
+			merged.EnvironmentMappings = append(merged.EnvironmentMappings, environmentMappingsResourceModel{
+				EnvironmentSlug: types.StringValue(em.EnvironmentSlug),
+				Branch:          types.StringValue(em.Branch),
+			})
+		}
+	}
+
+	input, err := getMutableCodeChangeSourceStruct(merged)
+	if err != nil {
+		return nil, err
+	}
+	for _, bm := range current.BuildMappings {
+		key := buildMappingKey(bm.EnvironmentSlug, bm.BuildName)
+		if !priorBuilds[key] && !plannedBuilds[key] {
+			input.BuildMappings = append(input.BuildMappings, bm)
+		}
+	}
+	input.SetBuildBranches()
+	return input, nil
+}
+
+// mappingKeys returns the environment slugs of the environment mappings and the keys of the build mappings of model
+func mappingKeys(model codeChangeResourceModel) (environments map[string]bool, builds map[string]bool) {
+	environments, builds = map[string]bool{}, map[string]bool{}
+	for _, em := range model.EnvironmentMappings {
+		environments[em.EnvironmentSlug.ValueString()] = true
+	}
+	for _, bm := range model.BuildMappings {
+		builds[buildMappingKey(bm.EnvironmentSlug.ValueString(), bm.BuildName.ValueString())] = true
+	}
+	return environments, builds
+}
+
+// getBuildMappingInput returns the mutation input of a planned build mapping of an environment mapped to buildBranch
+func getBuildMappingInput(bm buildMappingsResourceModel, buildBranch string) gqlclient.BuildMapping {
+	projectKey := bm.ProjectKey.ValueString()
+	projectName := bm.ProjectName.ValueString()
+
+	buildMapping := gqlclient.BuildMapping{
+		EnvironmentSlug:          bm.EnvironmentSlug.ValueString(),
+		Provider:                 bm.Provider.upper(),
+		BuildName:                bm.BuildName.ValueString(),
+		JobName:                  bm.JobName.ValueString(),
+		IntegrationSlug:          bm.IntegrationSlug.ValueString(),
+		BuildBranch:              buildBranch,
+		MatchBranchToEnvironment: bm.MatchBranchToEnvironment.ValueBool(),
+		IsCustom:                 bm.IsCustom.ValueBool(),
+	}
+
+	if projectKey != "" {
+		buildMapping.BuildProjectKey = projectKey
+	}
+	if projectName != "" {
+		buildMapping.BuildProjectName = projectName
+	}
+	return buildMapping
+}
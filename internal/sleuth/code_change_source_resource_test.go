@@ -2,12 +2,18 @@ package sleuth
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -47,25 +53,34 @@ func TestAccChangeSourceResource_v6(t *testing.T) {
 					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "deploy_tracking_type", "build"),
 					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "collect_impact", "true"),
 
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.name", "Terraform provider sleuth"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.provider", "GITHUB"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.url", "https://github.com/sleuth-io/terraform-provider-sleuth"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.name", "Terraform provider sleuth"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.url", "https://github.com/sleuth-io/terraform-provider-sleuth"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.provider"),
 
 					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.#", "2"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.0.environment_slug", "production"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.0.branch", "main"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.1.environment_slug", "staging"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.1.branch", "main"),
+					resource.TestCheckTypeSetElemNestedAttrs("sleuth_code_change_source.terraform_acc_test", "environment_mappings.*", map[string]string{
+						"environment_slug": "production",
+						"branch":           "main",
+						"id":               fmt.Sprintf("%s/production", projectSlug),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("sleuth_code_change_source.terraform_acc_test", "environment_mappings.*", map[string]string{
+						"environment_slug": "staging",
+						"branch":           "main",
+					}),
 
 					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.#", "2"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.environment_slug", "production"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.build_name", "release"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_key", "sleuth-io/terraform-provider-sleuth"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.match_branch_to_environment", "false"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.1.environment_slug", "staging"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.1.build_name", "Tests"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.1.project_key", "sleuth-io/terraform-provider-sleuth"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.1.match_branch_to_environment", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("sleuth_code_change_source.terraform_acc_test", "build_mappings.*", map[string]string{
+						"environment_slug":            "production",
+						"build_name":                  "release",
+						"project_key":                 "sleuth-io/terraform-provider-sleuth",
+						"match_branch_to_environment": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("sleuth_code_change_source.terraform_acc_test", "build_mappings.*", map[string]string{
+						"environment_slug":            "staging",
+						"build_name":                  "Tests",
+						"project_key":                 "sleuth-io/terraform-provider-sleuth",
+						"match_branch_to_environment": "true",
+					}),
 				),
 			},
 			{
@@ -74,7 +89,9 @@ func TestAccChangeSourceResource_v6(t *testing.T) {
 				Check: func(state *terraform.State) error {
 					// Manually add delay because if we try to delete Code change it will fail because it's still in initailizing state
 					// If you are getting errors with this test, try increasing the sleep time
-					time.Sleep(60 * time.Second)
+					if testAccMockServer == nil {
+						time.Sleep(60 * time.Second)
+					}
 					return nil
 				},
 			},
@@ -92,15 +109,19 @@ func TestAccChangeSourceResource_v6(t *testing.T) {
 					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "deploy_tracking_type", "manual"),
 					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "collect_impact", "false"),
 
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.name", "terraform-provider-sleuth"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.provider", "GITHUB"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.url", "https://github.com/sleuth-io/terraform-provider-sleuth"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.name", "terraform-provider-sleuth"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.url"),
 
 					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.#", "2"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.0.environment_slug", "production"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.0.branch", "main"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.1.environment_slug", "staging"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.1.branch", "main"),
+					resource.TestCheckTypeSetElemNestedAttrs("sleuth_code_change_source.terraform_acc_test", "environment_mappings.*", map[string]string{
+						"environment_slug": "production",
+						"branch":           "main",
+						"id":               fmt.Sprintf("%s/production", projectSlug),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("sleuth_code_change_source.terraform_acc_test", "environment_mappings.*", map[string]string{
+						"environment_slug": "staging",
+						"branch":           "main",
+					}),
 
 					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.#"),
 				),
@@ -108,40 +129,66 @@ func TestAccChangeSourceResource_v6(t *testing.T) {
 			// Test: Only project_name
 			{
 				Config: createCodeChangeConfigWithProjectName(projectString),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_name", "sleuth-io/terraform-provider-sleuth"),
-					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_key"),
-				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testAccExpectProductionReleaseBuildMapping(map[string]knownvalue.Check{
+						"project_name": knownvalue.StringExact("sleuth-io/terraform-provider-sleuth"),
+						"project_key":  knownvalue.NotNull(),
+					}),
+				},
+			},
+			// Test: Neither project_key nor project_name
+			{
+				Config: createCodeChangeConfigWithNeither(projectString),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testAccExpectProductionReleaseBuildMapping(map[string]knownvalue.Check{
+						"project_key":  knownvalue.Null(),
+						"project_name": knownvalue.Null(),
+					}),
+				},
 			},
 			// Test: Only project_key
 			{
 				Config: createCodeChangeConfigWithProjectKey(projectString),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_key", "sleuth-io/terraform-provider-sleuth"),
-					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_name"),
-				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testAccExpectProductionReleaseBuildMapping(map[string]knownvalue.Check{
+						"project_key":  knownvalue.StringExact("sleuth-io/terraform-provider-sleuth"),
+						"project_name": knownvalue.Null(),
+					}),
+				},
 			},
-			// Test: Both project_key and project_name (project_key takes precedence)
+			// ImportState testing
 			{
-				Config: createCodeChangeConfigWithBoth(projectString),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_key", "sleuth-io/terraform-provider-sleuth"),
-					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_name", "should-be-ignored"),
-				),
+				ResourceName:      "sleuth_code_change_source.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_code_change_source.terraform_acc_test"),
+				ImportStateVerify: true,
+				// the API does not return how the source was initialized
+				ImportStateVerifyIgnore: []string{"initialize_changes"},
 			},
-			// Test: Neither project_key nor project_name
 			{
-				Config: createCodeChangeConfigWithNeither(projectString),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_key"),
-					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "build_mappings.0.project_name"),
-				),
+				ResourceName:      "sleuth_code_change_source.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDByName("sleuth_code_change_source.terraform_acc_test"),
+				ImportStateVerify: true,
+				// the API does not return how the source was initialized
+				ImportStateVerifyIgnore: []string{"initialize_changes"},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+// testAccExpectProductionReleaseBuildMapping checks the build mapping of the release build to production
+func testAccExpectProductionReleaseBuildMapping(attributes map[string]knownvalue.Check) statecheck.StateCheck {
+	attributes["environment_slug"] = knownvalue.StringExact("production")
+	attributes["build_name"] = knownvalue.StringExact("release")
+	return statecheck.ExpectKnownValue(
+		"sleuth_code_change_source.terraform_acc_test",
+		tfjsonpath.New("build_mappings"),
+		knownvalue.SetPartial([]knownvalue.Check{knownvalue.ObjectPartial(attributes)}),
+	)
+}
+
 // Production env is created automatically, and we don't want to track it inside TF otherwise we can't delete project because of the env blocking deletion
 func createCodeChangeConfig(name string) string {
 	return fmt.Sprintf(`
@@ -158,10 +205,11 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
     project_slug = sleuth_project.terraform_acc_test.slug
     name = "Terraform code change source"
     repository = {
-        name = "Terraform provider sleuth"
-        owner = "sleuth-io"
-        provider = "GITHUB"
-        url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        github = {
+            owner = "sleuth-io"
+            name = "Terraform provider sleuth"
+            url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        }
     }
     environment_mappings = [
 		{
@@ -212,10 +260,10 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
     project_slug = sleuth_project.terraform_acc_test.slug
     name = "Terraform code change source updated"
     repository = {
-        name = "terraform-provider-sleuth"
-        owner = "sleuth-io"
-        provider = "GITHUB"
-        url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        github = {
+            owner = "sleuth-io"
+            name = "terraform-provider-sleuth"
+        }
     }
     environment_mappings = [
 		{
@@ -250,10 +298,11 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
     project_slug = sleuth_project.terraform_acc_test.slug
     name = "Terraform code change source"
     repository = {
-        name = "Terraform provider sleuth"
-        owner = "sleuth-io"
-        provider = "GITHUB"
-        url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        github = {
+            owner = "sleuth-io"
+            name = "Terraform provider sleuth"
+            url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        }
     }
     environment_mappings = [
 		{
@@ -299,10 +348,11 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
     project_slug = sleuth_project.terraform_acc_test.slug
     name = "Terraform code change source"
     repository = {
-        name = "Terraform provider sleuth"
-        owner = "sleuth-io"
-        provider = "GITHUB"
-        url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        github = {
+            owner = "sleuth-io"
+            name = "Terraform provider sleuth"
+            url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        }
     }
     environment_mappings = [
 		{
@@ -333,7 +383,7 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
 `, name)
 }
 
-func createCodeChangeConfigWithBoth(name string) string {
+func createCodeChangeConfigWithNeither(name string) string {
 	return fmt.Sprintf(`
 resource "sleuth_project" "terraform_acc_test" {
 	name = "%s"
@@ -348,10 +398,11 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
     project_slug = sleuth_project.terraform_acc_test.slug
     name = "Terraform code change source"
     repository = {
-        name = "Terraform provider sleuth"
-        owner = "sleuth-io"
-        provider = "GITHUB"
-        url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        github = {
+            owner = "sleuth-io"
+            name = "Terraform provider sleuth"
+            url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+        }
     }
     environment_mappings = [
 		{
@@ -367,8 +418,6 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
 		{
 			environment_slug = "production"
 			build_name = "release"
-			project_key = "sleuth-io/terraform-provider-sleuth"
-			project_name = "should-be-ignored"
 			provider = "GITHUB"
 		},
 		{
@@ -383,50 +432,387 @@ resource "sleuth_code_change_source" "terraform_acc_test" {
 `, name)
 }
 
-func createCodeChangeConfigWithNeither(name string) string {
+func TestAccChangeSourceResource_pathFilters(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, `path_filters = { include = ["services/*"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_filters.include.#", "1"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_filters.include.0", "services/*"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_filters.exclude"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_prefix", `{"excludes":[],"includes":["services/*"]}`),
+				),
+			},
+			// the deprecated path_prefix describing the same filter is not a change
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, `path_prefix = jsonencode({ includes = ["services/*"], excludes = [] })`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, `path_filters = {
+		include = ["services/*"]
+		exclude = ["services/docs/*"]
+	}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_filters.exclude.0", "services/docs/*"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_prefix", `{"excludes":["services/docs/*"],"includes":["services/*"]}`),
+				),
+			},
+			{
+				Config:      codeChangeConfigWithPathFilter(projectString, `path_filters = { include = ["services/[api"] }`),
+				ExpectError: regexp.MustCompile(`Invalid path filter`),
+			},
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, `path_filters = { include = ["services/*"] }
+	path_prefix = jsonencode({ includes = ["libs/*"] })`),
+				ExpectError: regexp.MustCompile(`Conflicting path filters`),
+			},
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_filters.include.#"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "path_prefix", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChangeSourceResource_initialization(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      codeChangeConfigWithPathFilter(projectString, `initial_history_days = 0`),
+				ExpectError: regexp.MustCompile(`Invalid initial_history_days`),
+			},
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, `initialize_changes = false
+	initial_history_days = 30`),
+				ExpectError: regexp.MustCompile(`Conflicting initialization`),
+			},
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, `initialize_changes = false`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "initialize_changes", "false"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "initial_history_days"),
+				),
+			},
+			// the initialization settings only apply at creation, so changing them replaces the source
+			{
+				Config: codeChangeConfigWithPathFilter(projectString, `initial_history_days = 30`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sleuth_code_change_source.terraform_acc_test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "initialize_changes", "true"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "initial_history_days", "30"),
+				),
+			},
+			{
+				ResourceName:      "sleuth_code_change_source.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_code_change_source.terraform_acc_test"),
+				ImportStateVerify: true,
+				// the API does not return how the source was initialized
+				ImportStateVerifyIgnore: []string{"initialize_changes", "initial_history_days"},
+			},
+		},
+	})
+}
+
+func codeChangeConfigWithPathFilter(name, pathFilter string) string {
 	return fmt.Sprintf(`
 resource "sleuth_project" "terraform_acc_test" {
 	name = "%s"
 }
 
-resource "sleuth_environment" "terraform_acc_test" {
-	project_slug = sleuth_project.terraform_acc_test.slug
-	name = "staging"
-}
-
 resource "sleuth_code_change_source" "terraform_acc_test" {
-    project_slug = sleuth_project.terraform_acc_test.slug
-    name = "Terraform code change source"
-    repository = {
-        name = "Terraform provider sleuth"
-        owner = "sleuth-io"
-        provider = "GITHUB"
-        url = "https://github.com/sleuth-io/terraform-provider-sleuth"
-    }
-    environment_mappings = [
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "Terraform code change source"
+	repository = {
+		github = {
+			owner = "sleuth-io"
+			name = "terraform-provider-sleuth"
+		}
+	}
+	environment_mappings = [
 		{
 			environment_slug = "production"
 			branch = "main"
+		}
+	]
+	deploy_tracking_type = "manual"
+	%s
+}
+`, name, pathFilter)
+}
+
+func TestAccChangeSourceResource_repositoryProviders(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	githubBlock := `github = {
+			owner = "sleuth-io"
+			name = "terraform-provider-sleuth"
+		}`
+	azureBlock := `azure = {
+			owner = "sleuth"
+			name = "terraform-provider-sleuth"
+			url = "https://dev.azure.com/sleuth/terraform/_git/terraform-provider-sleuth"
+			project_uid = "7d3c0f"
+			repo_uid = "b1a2e9"
+		}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      codeChangeConfigWithRepository(projectString, githubBlock+"\n\t\tgitlab = { owner = \"sleuth-io\", name = \"payments\" }"),
+				ExpectError: regexp.MustCompile(`Conflicting repository providers`),
+			},
+			{
+				Config:      codeChangeConfigWithRepository(projectString, githubBlock+"\n\t\tprovider = \"GITHUB\""),
+				ExpectError: regexp.MustCompile(`Conflicting repository attributes`),
+			},
+			{
+				Config:      codeChangeConfigWithRepository(projectString, ""),
+				ExpectError: regexp.MustCompile(`Missing repository provider`),
+			},
+			{
+				Config:      codeChangeConfigWithRepository(projectString, azureBlock),
+				ExpectError: regexp.MustCompile(`Missing integration_slug`),
+			},
+			{
+				Config: codeChangeConfigWithRepository(projectString, `owner = "sleuth"
+		name = "terraform-provider-sleuth"
+		url = "https://dev.azure.com/sleuth/terraform/_git/terraform-provider-sleuth"
+		provider = "AZURE"
+		integration_slug = "azure"`),
+				ExpectError: regexp.MustCompile(`project_uid is required for AZURE repositories`),
+			},
+			// the deprecated attributes keep working
+			{
+				Config: codeChangeConfigWithRepository(projectString, `owner = "sleuth-io"
+		name = "terraform-provider-sleuth"
+		url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+		provider = "GITHUB"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.provider", "GITHUB"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.%"),
+				),
+			},
+			// moving to the provider block of the same repository does not replace the source
+			{
+				Config: codeChangeConfigWithRepository(projectString, githubBlock),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sleuth_code_change_source.terraform_acc_test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.owner", "sleuth-io"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.url"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.provider"),
+				),
+			},
+			{
+				Config: codeChangeConfigWithRepository(projectString, githubBlock),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+			{
+				Config: codeChangeConfigWithRepository(projectString, `gitlab = {
+			owner = "sleuth-io"
+			name = "payments"
+		}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.gitlab.name", "payments"),
+					resource.TestCheckNoResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.github.%"),
+				),
+			},
+			{
+				Config: codeChangeConfigWithRepository(projectString, azureBlock+"\n\t\tintegration_slug = \"azure\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.azure.project_uid", "7d3c0f"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.azure.repo_uid", "b1a2e9"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "repository.integration_slug", "azure"),
+				),
+			},
+			{
+				ResourceName:      "sleuth_code_change_source.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_code_change_source.terraform_acc_test"),
+				ImportStateVerify: true,
+				// the API does not return how the source was initialized
+				ImportStateVerifyIgnore: []string{"initialize_changes"},
+			},
 		},
+	})
+}
+
+func codeChangeConfigWithRepository(name, repository string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_code_change_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "Terraform code change source"
+	repository = {
+		%s
+	}
+	environment_mappings = [
 		{
-			environment_slug = sleuth_environment.terraform_acc_test.slug
+			environment_slug = "production"
 			branch = "main"
 		}
 	]
-	build_mappings = [
-		{
+	deploy_tracking_type = "manual"
+}
+`, name, repository)
+}
+
+func TestAccChangeSourceResource_mappingOrder(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	productionMapping := `{ environment_slug = "production", branch = "main" }`
+	stagingMapping := `{ environment_slug = sleuth_environment.terraform_acc_test.slug, branch = "develop" }`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      codeChangeConfigWithEnvironmentMappings(projectString, productionMapping, `{ environment_slug = "production", branch = "release" }`),
+				ExpectError: regexp.MustCompile("Duplicate environment mapping"),
+			},
+			{
+				Config: codeChangeConfigWithEnvironmentMappings(projectString, productionMapping, stagingMapping),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("sleuth_code_change_source.terraform_acc_test", "environment_mappings.*", map[string]string{
+						"environment_slug": "staging",
+						"branch":           "develop",
+					}),
+				),
+			},
+			// Reordering mappings is not a change
+			{
+				Config: codeChangeConfigWithEnvironmentMappings(projectString, stagingMapping, productionMapping),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccChangeSourceResource_buildMappingValidation(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      codeChangeConfigWithBuildMappings(projectString, ""),
+				ExpectError: regexp.MustCompile(`build_mappings is required when deploy_tracking_type is build`),
+			},
+			{
+				Config:      codeChangeConfigWithBuildMappings(projectString, `{ environment_slug = "staging", build_name = "release", provider = "GITHUB" }`),
+				ExpectError: regexp.MustCompile(`Build release is mapped to environment staging, which has no\s+environment_mappings entry`),
+			},
+			{
+				Config: codeChangeConfigWithBuildMappings(projectString, `{
 			environment_slug = "production"
 			build_name = "release"
 			provider = "GITHUB"
+			project_key = "sleuth-io/terraform-provider-sleuth"
+			project_name = "terraform-provider-sleuth"
+		}`),
+				ExpectError: regexp.MustCompile(`Build release of environment production sets both project_key and\s+project_name`),
+			},
 		},
-		{
-			environment_slug = sleuth_environment.terraform_acc_test.slug
-			build_name = "dummy"
-			provider = "GITHUB"
+	})
+}
+
+func codeChangeConfigWithBuildMappings(name string, buildMappings ...string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_code_change_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "Terraform code change source"
+	repository = {
+		github = {
+			owner = "sleuth-io"
+			name = "terraform-provider-sleuth"
 		}
-	]
-   	deploy_tracking_type = "build"
-   	collect_impact = true
+	}
+	environment_mappings = [{ environment_slug = "production", branch = "main" }]
+	build_mappings = [%s]
+	deploy_tracking_type = "build"
 }
-`, name)
+`, name, strings.Join(buildMappings, ", "))
+}
+
+func codeChangeConfigWithEnvironmentMappings(name string, environmentMappings ...string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_environment" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "staging"
+}
+
+resource "sleuth_code_change_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "Terraform code change source"
+	repository = {
+		name = "Terraform provider sleuth"
+		owner = "sleuth-io"
+		provider = "GITHUB"
+		url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+	}
+	environment_mappings = [%s]
+	deploy_tracking_type = "manual"
+	collect_impact = true
+}
+`, name, strings.Join(environmentMappings, ", "))
 }
@@ -0,0 +1,688 @@
+package sleuth
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// loadFixtureCase decodes the case name of a fixture in testdata/conversion, a JSON object keyed by test case, into v
+func loadFixtureCase(t *testing.T, fixture, name string, v interface{}) {
+	t.Helper()
+	var cases map[string]json.RawMessage
+	loadRecordedResponse(t, filepath.Join("conversion", fixture), &cases)
+	data, ok := cases[name]
+	if !ok {
+		t.Fatalf("no case %q in fixture %s", name, fixture)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func stringSet(t *testing.T, values ...string) types.Set {
+	t.Helper()
+	var elems []attr.Value
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	set, diags := types.SetValue(types.StringType, elems)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return set
+}
+
+func TestGetMutableCodeChangeSourceStruct(t *testing.T) {
+	production := environmentMappingsResourceModel{EnvironmentSlug: types.StringValue("production"), Branch: types.StringValue("main")}
+	githubRepository := &repositoryResourceModel{
+		Owner:    types.StringValue("sleuth-io"),
+		Name:     types.StringValue("payments"),
+		Provider: newCaseInsensitiveStringValue("GITHUB"),
+	}
+	azureRepository := func(projectUID, repoUID string) *repositoryResourceModel {
+		return &repositoryResourceModel{
+			Owner:           types.StringValue("sleuth"),
+			Name:            types.StringValue("payments"),
+			Provider:        newCaseInsensitiveStringValue("azure"),
+			ProjectUID:      types.StringValue(projectUID),
+			RepoUID:         types.StringValue(repoUID),
+			IntegrationSlug: types.StringValue("azure"),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		plan          codeChangeResourceModel
+		err           string
+		validationErr string
+	}{
+		{
+			name: "build branches follow environment mappings",
+			plan: codeChangeResourceModel{
+				Name:               types.StringValue("Payments API"),
+				Repository:         githubRepository,
+				DeployTrackingType: newCaseInsensitiveStringValue("build"),
+				CollectImpact:      types.BoolValue(true),
+				IncludeInDashboard: types.BoolValue(true),
+				EnvironmentMappings: []environmentMappingsResourceModel{
+					production,
+					{EnvironmentSlug: types.StringValue("staging"), Branch: types.StringValue("develop")},
+				},
+				BuildMappings: []buildMappingsResourceModel{
+					{
+						EnvironmentSlug: types.StringValue("staging"),
+						Provider:        newCaseInsensitiveStringValue("CIRCLECI"),
+						BuildName:       types.StringValue("deploy"),
+						ProjectKey:      types.StringUnknown(),
+						ProjectName:     types.StringValue("payments"),
+					},
+					{
+						EnvironmentSlug:          types.StringValue("production"),
+						Provider:                 newCaseInsensitiveStringValue("GITHUB"),
+						BuildName:                types.StringValue("release"),
+						JobName:                  types.StringValue("ship"),
+						ProjectKey:               types.StringValue("sleuth-io/payments"),
+						ProjectName:              types.StringNull(),
+						IntegrationSlug:          types.StringValue("github"),
+						MatchBranchToEnvironment: types.BoolValue(true),
+					},
+				},
+			},
+		},
+		{
+			name: "build mapping without an environment mapping",
+			plan: codeChangeResourceModel{
+				Name:                types.StringValue("Payments API"),
+				Repository:          githubRepository,
+				EnvironmentMappings: []environmentMappingsResourceModel{production},
+				BuildMappings: []buildMappingsResourceModel{{
+					EnvironmentSlug: types.StringValue("staging"),
+					Provider:        newCaseInsensitiveStringValue("CIRCLECI"),
+					BuildName:       types.StringValue("deploy"),
+				}},
+			},
+			err: "could not find branch for build mapping for environment slug: staging. Did you forget to include this or all environments in the `environment_mappings` field?",
+		},
+		{
+			name: "providers are upper-cased",
+			plan: codeChangeResourceModel{
+				Name: types.StringValue("Payments API"),
+				Repository: &repositoryResourceModel{
+					Owner:    types.StringValue("sleuth-io"),
+					Name:     types.StringValue("payments"),
+					Provider: newCaseInsensitiveStringValue("gitlab"),
+					URL:      types.StringValue("https://gitlab.com/sleuth-io/payments"),
+				},
+				DeployTrackingType:  newCaseInsensitiveStringValue("manual"),
+				PathPrefix:          newPathPrefixValue("services/payments"),
+				NotifyInSlack:       types.BoolValue(true),
+				AutoTrackingDelay:   types.Int64Value(120),
+				EnvironmentMappings: []environmentMappingsResourceModel{production},
+				BuildMappings: []buildMappingsResourceModel{{
+					EnvironmentSlug: types.StringValue("production"),
+					Provider:        newCaseInsensitiveStringValue("bitbucket_pipelines"),
+					BuildName:       types.StringValue("deploy"),
+					IsCustom:        types.BoolValue(true),
+				}},
+			},
+		},
+		{
+			name: "azure repository",
+			plan: codeChangeResourceModel{
+				Name:                types.StringValue("Payments API"),
+				Repository:          azureRepository("7d3c0f", "b1a2e9"),
+				DeployTrackingType:  newCaseInsensitiveStringValue("manual"),
+				EnvironmentMappings: []environmentMappingsResourceModel{production},
+			},
+		},
+		{
+			name: "azure repository without uids",
+			plan: codeChangeResourceModel{
+				Name:                types.StringValue("Payments API"),
+				Repository:          azureRepository("", ""),
+				DeployTrackingType:  newCaseInsensitiveStringValue("manual"),
+				EnvironmentMappings: []environmentMappingsResourceModel{production},
+			},
+			validationErr: "project_uid, repo_uid and integration_slug are required for AZURE provider",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := getMutableCodeChangeSourceStruct(tt.plan)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var expected gqlclient.MutableCodeChangeSource
+			loadFixtureCase(t, "mutable_code_change_source.json", tt.name, &expected)
+			if diff := cmp.Diff(&expected, input); diff != "" {
+				t.Errorf("unexpected input (-expected +got):\n%s", diff)
+			}
+
+			err = validateCodeChangeInput(*input)
+			if tt.validationErr == "" && err != nil {
+				t.Errorf("unexpected validation error %v", err)
+			}
+			if tt.validationErr != "" && (err == nil || err.Error() != tt.validationErr) {
+				t.Errorf("expected validation error %q, got %v", tt.validationErr, err)
+			}
+		})
+	}
+}
+
+func TestGetNewStateFromCodeChangeSource(t *testing.T) {
+	productionMapping := []environmentMappingsResourceModel{{
+		EnvironmentSlug: types.StringValue("production"),
+		Branch:          types.StringValue("main"),
+		ID:              types.StringValue("payments/production"),
+	}}
+	webhook, diags := types.ObjectValueFrom(context.Background(), webhookResourceModel{}.AttributeTypes(), webhookResourceModel{
+		URL:    types.StringValue("https://app.sleuth.io/api/1/deployments/payments/register_deploy"),
+		Secret: types.StringValue("s3cr3t"),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	githubState := func(repositoryProvider, buildProvider string, projectName types.String) codeChangeResourceModel {
+		return codeChangeResourceModel{
+			ProjectSlug: types.StringValue("payments"),
+			Name:        types.StringValue("Payments API"),
+			Slug:        types.StringValue("payments-api"),
+			ID:          types.StringValue("payments-api"),
+			Repository: withoutRepositoryBlocks(repositoryResourceModel{
+				Owner:           types.StringValue("sleuth-io"),
+				Name:            types.StringValue("payments"),
+				URL:             types.StringValue("https://github.com/sleuth-io/payments"),
+				Provider:        newCaseInsensitiveStringValue(repositoryProvider),
+				IntegrationSlug: types.StringValue("github"),
+				RepoUID:         types.StringNull(),
+				ProjectUID:      types.StringNull(),
+				Webhook:         webhook,
+			}),
+			EnvironmentMappings: productionMapping,
+			BuildMappings: []buildMappingsResourceModel{{
+				EnvironmentSlug:          types.StringValue("production"),
+				Provider:                 newCaseInsensitiveStringValue(buildProvider),
+				IntegrationSlug:          types.StringNull(),
+				BuildName:                types.StringValue("deploy"),
+				JobName:                  types.StringNull(),
+				ProjectKey:               types.StringValue("gh/sleuth-io/payments"),
+				ProjectName:              projectName,
+				MatchBranchToEnvironment: types.BoolValue(true),
+				IsCustom:                 types.BoolValue(false),
+			}},
+			DeployTrackingType: newCaseInsensitiveStringValue("build"),
+			CollectImpact:      types.BoolValue(true),
+			PathPrefix:         newPathPrefixValue(""),
+			PathFilters:        types.ObjectNull(pathFiltersResourceModel{}.AttributeTypes()),
+			NotifyInSlack:      types.BoolValue(false),
+			IncludeInDashboard: types.BoolValue(true),
+			AutoTrackingDelay:  types.Int64Value(0),
+		}
+	}
+	manualState := func(owner, url, provider string, integrationSlug, projectUID, repoUID types.String) codeChangeResourceModel {
+		return codeChangeResourceModel{
+			ProjectSlug: types.StringValue("payments"),
+			Name:        types.StringValue("Payments API"),
+			Slug:        types.StringValue("payments-api"),
+			ID:          types.StringValue("payments-api"),
+			Repository: withoutRepositoryBlocks(repositoryResourceModel{
+				Owner:           types.StringValue(owner),
+				Name:            types.StringValue("payments"),
+				URL:             types.StringValue(url),
+				Provider:        newCaseInsensitiveStringValue(provider),
+				IntegrationSlug: integrationSlug,
+				RepoUID:         repoUID,
+				ProjectUID:      projectUID,
+				Webhook:         types.ObjectNull(webhookResourceModel{}.AttributeTypes()),
+			}),
+			EnvironmentMappings: productionMapping,
+			// without build mappings in the API or the plan, the plan's (nil) build mappings are kept
+			BuildMappings:      nil,
+			DeployTrackingType: newCaseInsensitiveStringValue("manual"),
+			CollectImpact:      types.BoolValue(false),
+			PathPrefix:         newPathPrefixValue(""),
+			PathFilters:        types.ObjectNull(pathFiltersResourceModel{}.AttributeTypes()),
+			NotifyInSlack:      types.BoolValue(false),
+			IncludeInDashboard: types.BoolValue(false),
+			AutoTrackingDelay:  types.Int64Value(0),
+		}
+	}
+	// blockState is a state using the provider block of the repository instead of the deprecated attributes
+	blockState := func(state codeChangeResourceModel, attribute string, attributes map[string]attr.Value) codeChangeResourceModel {
+		repository := withoutRepositoryBlocks(*state.Repository)
+		repository.Owner, repository.Name, repository.URL, repository.Provider = types.StringNull(), types.StringNull(), types.StringNull(), newCaseInsensitiveStringNull()
+		repository.RepoUID, repository.ProjectUID = types.StringNull(), types.StringNull()
+		idx, _ := findRepositoryBlock(attribute)
+		*repository.blocks()[idx] = types.ObjectValueMust(repositoryBlocks[idx].AttributeTypes(), attributes)
+		state.Repository = repository
+		return state
+	}
+	githubBlock, diags := types.ObjectValue(repositoryBlocks[0].AttributeTypes(), map[string]attr.Value{
+		"owner": types.StringValue("sleuth-io"),
+		"name":  types.StringValue("payments"),
+		"url":   types.StringValue("https://github.com/sleuth-io/payments"),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	priorBuildMapping := func(provider string, projectKey, projectName types.String) []buildMappingsResourceModel {
+		return []buildMappingsResourceModel{{
+			EnvironmentSlug: types.StringValue("production"),
+			Provider:        newCaseInsensitiveStringValue(provider),
+			BuildName:       types.StringValue("deploy"),
+			ProjectKey:      projectKey,
+			ProjectName:     projectName,
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		fixture  string
+		plan     codeChangeResourceModel
+		expected codeChangeResourceModel
+	}{
+		{
+			name:    "import uses the provider block and leaves out the default url",
+			fixture: "github",
+			expected: blockState(githubState("GITHUB", "circleci", types.StringNull()), "github", map[string]attr.Value{
+				"owner": types.StringValue("sleuth-io"),
+				"name":  types.StringValue("payments"),
+				"url":   types.StringNull(),
+			}),
+		},
+		{
+			name:    "configured url of the provider block is kept",
+			fixture: "github",
+			plan: codeChangeResourceModel{
+				Repository: &repositoryResourceModel{GitHub: githubBlock},
+			},
+			expected: blockState(githubState("GITHUB", "circleci", types.StringNull()), "github", githubBlock.Attributes()),
+		},
+		{
+			// the configured case is kept by semantic equality, the state holds the API's
+			name:    "providers are in the case of the API",
+			fixture: "github",
+			plan: codeChangeResourceModel{
+				Repository:    &repositoryResourceModel{Provider: newCaseInsensitiveStringValue("github")},
+				BuildMappings: priorBuildMapping("CircleCI", types.StringUnknown(), types.StringNull()),
+			},
+			expected: githubState("GITHUB", "circleci", types.StringNull()),
+		},
+		{
+			name:    "changed providers replace configured ones",
+			fixture: "github",
+			plan: codeChangeResourceModel{
+				Repository:    &repositoryResourceModel{Provider: newCaseInsensitiveStringValue("gitlab")},
+				BuildMappings: priorBuildMapping("buildkite", types.StringUnknown(), types.StringNull()),
+			},
+			expected: githubState("GITHUB", "circleci", types.StringNull()),
+		},
+		{
+			name:    "project name missing from the API is not kept",
+			fixture: "github",
+			plan: codeChangeResourceModel{
+				Repository:    &repositoryResourceModel{Provider: newCaseInsensitiveStringValue("GITHUB")},
+				BuildMappings: priorBuildMapping("CIRCLECI", types.StringValue("gh/sleuth-io/payments"), types.StringValue("payments")),
+			},
+			expected: githubState("GITHUB", "circleci", types.StringNull()),
+		},
+		{
+			name:    "azure repository uids",
+			fixture: "azure",
+			plan: codeChangeResourceModel{
+				Repository: &repositoryResourceModel{Provider: newCaseInsensitiveStringValue("AZURE")},
+			},
+			expected: manualState("sleuth", "https://dev.azure.com/sleuth/payments/_git/payments", "azure",
+				types.StringValue("azure"), types.StringValue("7d3c0f"), types.StringValue("b1a2e9")),
+		},
+		{
+			name:    "import of an azure repository",
+			fixture: "azure",
+			expected: blockState(manualState("sleuth", "https://dev.azure.com/sleuth/payments/_git/payments", "azure",
+				types.StringValue("azure"), types.StringNull(), types.StringNull()), "azure", map[string]attr.Value{
+				"owner":       types.StringValue("sleuth"),
+				"name":        types.StringValue("payments"),
+				"url":         types.StringValue("https://dev.azure.com/sleuth/payments/_git/payments"),
+				"project_uid": types.StringValue("7d3c0f"),
+				"repo_uid":    types.StringValue("b1a2e9"),
+			}),
+		},
+		{
+			name:    "repository uids are only read for azure",
+			fixture: "gitlab uids ignored",
+			plan: codeChangeResourceModel{
+				Repository: &repositoryResourceModel{Provider: newCaseInsensitiveStringValue("GITLAB")},
+			},
+			expected: manualState("sleuth-io", "https://gitlab.com/sleuth-io/payments", "GITLAB",
+				types.StringNull(), types.StringNull(), types.StringNull()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ccs gqlclient.CodeChangeSource
+			loadFixtureCase(t, "code_change_source.json", tt.fixture, &ccs)
+
+			state, diags := getNewStateFromCodeChangeSource(context.Background(), &ccs, "payments", tt.plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if diff := cmp.Diff(tt.expected, state); diff != "" {
+				t.Errorf("unexpected state (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetProviderSpecificData(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		data     providerData
+	}{
+		{
+			// the integration slug of PagerDuty sources is not sent
+			name:     "pagerduty",
+			provider: "PAGERDUTY",
+			data: providerData{pagerduty: &pagerDutyInputResourceModel{
+				RemoteServices:  types.StringValue("P123,P456"),
+				RemoteUrgency:   newCaseInsensitiveStringValue("HIGH"),
+				IntegrationSlug: types.StringValue("pagerduty"),
+			}},
+		},
+		{
+			name:     "datadog",
+			provider: "DATADOG",
+			data: providerData{datadog: &dataDogInputResourceModel{
+				Query:                   types.StringValue("service:payments"),
+				RemotePriorityThreshold: newCaseInsensitiveStringValue("P2"),
+				IntegrationSlug:         types.StringValue("datadog"),
+			}},
+		},
+		{
+			name:     "blameless",
+			provider: "BLAMELESS",
+			data: providerData{blameless: &blamelessInputResourceModel{
+				RemoteTypes:             stringSet(t, "Outage", "Security"),
+				RemoteSeverityThreshold: types.StringValue("SEV1"),
+				IntegrationSlug:         types.StringValue("blameless"),
+			}},
+		},
+		{
+			name:     "statuspage",
+			provider: "STATUSPAGE",
+			data: providerData{statuspage: &statuspageInputResourceModel{
+				RemotePage:                 types.StringValue("page"),
+				RemoteComponent:            types.StringValue("api"),
+				RemoteImpact:               types.StringValue("major"),
+				IgnoreMaintenanceIncidents: types.BoolValue(true),
+				IntegrationSlug:            types.StringValue("statuspage"),
+			}},
+		},
+		{
+			name:     "opsgenie",
+			provider: "OPSGENIE",
+			data: providerData{opsgenie: &opsgenieInputResourceModel{
+				RemoteAlertTags:         types.StringValue("payments"),
+				RemoteIncidentTags:      types.StringNull(),
+				RemotePriorityThreshold: newCaseInsensitiveStringValue("P3"),
+				RemoteService:           types.StringValue("payments"),
+				RemoteUseAlerts:         types.BoolValue(true),
+				IntegrationSlug:         types.StringValue("opsgenie"),
+			}},
+		},
+		{
+			name:     "firehydrant",
+			provider: "FIREHYDRANT",
+			data: providerData{firehydrant: &firehydrantInputResourceModel{
+				RemoteEnvironments:       types.StringValue("production"),
+				RemoteServices:           types.StringValue("payments"),
+				RemoteMitigatedIsHealthy: types.BoolValue(true),
+			}},
+		},
+		{
+			name:     "rootly",
+			provider: "ROOTLY",
+			data: providerData{rootly: &rootlyInputResourceModel{
+				RemoteSeverity:    types.StringValue("critical"),
+				RemoteEnvironment: types.StringValue("production"),
+				RemoteService:     types.StringValue("payments"),
+				IntegrationSlug:   types.StringValue("rootly"),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := gqlclient.IncidentImpactSourceInputType{
+				ProjectSlug:     "payments",
+				EnvironmentName: "production",
+				Name:            "Incidents",
+				Provider:        tt.provider,
+			}
+			input, diags := getProviderSpecificData(context.Background(), input, tt.data)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+
+			var expected gqlclient.IncidentImpactSourceInputType
+			loadFixtureCase(t, "incident_provider_data.json", tt.name, &expected)
+			if diff := cmp.Diff(expected, input); diff != "" {
+				t.Errorf("unexpected input (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNewStateFromIncidentImpactSource(t *testing.T) {
+	incidentState := func(slug, environmentName, name, providerName string) incidentImpactResourceModel {
+		return incidentImpactResourceModel{
+			ID:              types.StringValue(slug),
+			Slug:            types.StringValue(slug),
+			ProjectSlug:     types.StringValue("payments"),
+			EnvironmentName: types.StringValue(environmentName),
+			Name:            types.StringValue(name),
+			ProviderName:    newCaseInsensitiveStringValue(providerName),
+		}
+	}
+	datadogImported := incidentState("datadog-incidents", "Production", "Datadog incidents", "DATADOG")
+	datadogImported.DataDogInput = &dataDogInputResourceModel{
+		Query:                   types.StringValue("service:payments"),
+		RemotePriorityThreshold: newCaseInsensitiveStringValue("P2"),
+		IntegrationSlug:         types.StringValue("datadog"),
+	}
+	jira := incidentState("jira-incidents", "Production", "Jira incidents", "JIRA")
+	jira.JiraInput = &jiraInputResourceModel{
+		RemoteJQL:       types.StringValue("type = Incident"),
+		IntegrationSlug: types.StringNull(),
+	}
+	blamelessChanged := incidentState("blameless-incidents", "Staging", "Blameless incidents", "BLAMELESS")
+	blamelessChanged.BlamelessInput = &blamelessInputResourceModel{
+		RemoteTypes:             stringSet(t, "Outage"),
+		RemoteSeverityThreshold: types.StringValue("SEV1"),
+	}
+
+	tests := []struct {
+		name     string
+		fixture  string
+		expected incidentImpactResourceModel
+	}{
+		{
+			name:     "import derives the provider block",
+			fixture:  "datadog",
+			expected: datadogImported,
+		},
+		{
+			name:     "provider name is in the case of the API",
+			fixture:  "jira",
+			expected: jira,
+		},
+		{
+			name:     "provider changed outside of Terraform",
+			fixture:  "blameless",
+			expected: blamelessChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var iis gqlclient.IncidentImpactSource
+			loadFixtureCase(t, "incident_impact_source.json", tt.fixture, &iis)
+
+			state, diags := getNewStateFromIncidentImpactSource(context.Background(), &iis, "payments")
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if diff := cmp.Diff(tt.expected, state); diff != "" {
+				t.Errorf("unexpected state (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetMutableProjectStruct(t *testing.T) {
+	issueStates, diags := types.SetValue(types.Int64Type, []attr.Value{types.Int64Value(3)})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	labels, diags := types.ListValue(types.StringType, []attr.Value{types.StringValue("team:payments"), types.StringValue("tier:1")})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	emptyLabels, _ := types.ListValue(types.StringType, []attr.Value{})
+
+	tests := []struct {
+		name string
+		plan projectResourceModel
+	}{
+		{
+			name: "all settings",
+			plan: projectResourceModel{
+				Name:                          types.StringValue("Payments"),
+				Description:                   types.StringValue("Card payments"),
+				IssueTrackerProviderType:      newCaseInsensitiveStringValue("JIRA"),
+				BuildProvider:                 newCaseInsensitiveStringValue("CIRCLECI"),
+				ChangeFailureRateBoundary:     newCaseInsensitiveStringValue("UNHEALTHY"),
+				ImpactSensitivity:             newCaseInsensitiveStringValue("FINE"),
+				FailureSensitivity:            types.Int64Value(600),
+				ChangeLeadTimeStartDefinition: newCaseInsensitiveStringValue("ISSUE"),
+				ChangeLeadTimeIssueStates:     issueStates,
+				ChangeLeadTimeStrictMatching:  types.BoolValue(true),
+				Labels:                        labels,
+			},
+		},
+		{
+			// labels is not omitted from the input, so unset labels are sent as null
+			name: "null labels",
+			plan: projectResourceModel{
+				Name:                      types.StringValue("Payments"),
+				ChangeLeadTimeIssueStates: types.SetNull(types.Int64Type),
+				Labels:                    types.ListNull(types.StringType),
+			},
+		},
+		{
+			name: "empty labels",
+			plan: projectResourceModel{
+				Name:                      types.StringValue("Payments"),
+				ChangeLeadTimeIssueStates: types.SetNull(types.Int64Type),
+				Labels:                    emptyLabels,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := getMutableProjectStruct(context.Background(), tt.plan)
+
+			var expected gqlclient.MutableProject
+			loadFixtureCase(t, "mutable_project.json", tt.name, &expected)
+			if diff := cmp.Diff(expected, input); diff != "" {
+				t.Errorf("unexpected input (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNewStateFromTeam(t *testing.T) {
+	members, diags := types.ListValue(types.StringType, []attr.Value{types.StringValue("dbrown@sleuth.io"), types.StringValue("detkin@sleuth.io")})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	noMembers, _ := types.ListValue(types.StringType, nil)
+
+	tests := []struct {
+		name         string
+		memberEmails []string
+		membersNull  bool
+		expected     teamResourceModel
+	}{
+		{
+			name:        "root team",
+			membersNull: true,
+			expected: teamResourceModel{
+				ID:         types.StringValue("VGVhbTox"),
+				Name:       types.StringValue("Engineering"),
+				Slug:       types.StringValue("engineering"),
+				ParentSlug: types.StringNull(),
+				Members:    types.ListNull(types.StringType),
+			},
+		},
+		{
+			name:         "subteam",
+			memberEmails: []string{"dbrown@sleuth.io", "detkin@sleuth.io"},
+			expected: teamResourceModel{
+				ID:         types.StringValue("VGVhbToy"),
+				Name:       types.StringValue("Payments"),
+				Slug:       types.StringValue("payments"),
+				ParentSlug: types.StringValue("engineering"),
+				Members:    members,
+			},
+		},
+		{
+			name: "empty parent slug",
+			expected: teamResourceModel{
+				ID:         types.StringValue("VGVhbToz"),
+				Name:       types.StringValue("Platform"),
+				Slug:       types.StringValue("platform"),
+				ParentSlug: types.StringNull(),
+				Members:    noMembers,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var team gqlclient.Team
+			loadFixtureCase(t, "team.json", tt.name, &team)
+
+			state := getNewStateFromTeam(&team, tt.memberEmails, tt.membersNull)
+			if diff := cmp.Diff(tt.expected, state); diff != "" {
+				t.Errorf("unexpected state (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// withoutRepositoryBlocks returns r with all provider blocks null
+func withoutRepositoryBlocks(r repositoryResourceModel) *repositoryResourceModel {
+	for idx, value := range r.blocks() {
+		*value = types.ObjectNull(repositoryBlocks[idx].AttributeTypes())
+	}
+	return &r
+}
@@ -0,0 +1,136 @@
+package sleuth
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// fieldAliases maps API input field names (in snake case) to the Terraform attribute names they are exposed as. An
+// empty name flattens the field into its parent.
+type fieldAliases map[string]string
+
+var (
+	projectFieldAliases = fieldAliases{
+		"issue_tracker_provider": "issue_tracker_provider_type",
+		"clt_start_definition":   "change_lead_time_start_definition",
+		"clt_start_states":       "change_lead_time_issue_states",
+		"strict_issue_matching":  "change_lead_time_strict_matching",
+	}
+	codeChangeSourceFieldAliases = fieldAliases{
+		"build_project_key":  "project_key",
+		"build_project_name": "project_name",
+	}
+	impactSourceFieldAliases = fieldAliases{
+		"environment": "environment_slug",
+		"provider":    "provider_type",
+		"auth":        "integration_slug",
+	}
+	incidentImpactSourceFieldAliases = fieldAliases{
+		"provider":         "provider_name",
+		"pager_duty_input": "pagerduty_input",
+	}
+	teamFieldAliases = fieldAliases{
+		"parent": "parent_slug",
+	}
+	integrationAuthFieldAliases = fieldAliases{
+		"provider":    "provider_type",
+		"datadog":     "datadog_credentials",
+		"pager_duty":  "pagerduty_credentials",
+		"sentry":      "sentry_credentials",
+		"jenkins":     "jenkins_credentials",
+		"cloud_watch": "cloudwatch_credentials",
+	}
+)
+
+// Fields the API uses for errors that are not tied to a single input field
+var nonFieldErrorFields = map[string]bool{
+	"":                 true,
+	"__all__":          true,
+	"non_field_errors": true,
+}
+
+var fieldIndexRegexp = regexp.MustCompile(`\[(\d+)\]`)
+
+// addMutationErrorDiagnostics reports err. Field errors of a gqlclient.MutationError are attached to the matching
+// attribute, so Terraform points at the offending configuration. Any other error is reported with detail.
+func addMutationErrorDiagnostics(diags *diag.Diagnostics, summary string, detail string, err error, aliases fieldAliases) {
+	var mutationErr *gqlclient.MutationError
+	if !errors.As(err, &mutationErr) || len(mutationErr.Errors) == 0 {
+		diags.AddError(summary, detail)
+		return
+	}
+
+	for _, fieldError := range mutationErr.Errors {
+		message := strings.Join(fieldError.Messages, " ")
+		if nonFieldErrorFields[fieldError.Field] {
+			diags.AddError(summary, message)
+			continue
+		}
+		diags.AddAttributeError(attributePathFromField(fieldError.Field, aliases), summary, message)
+	}
+}
+
+// Set-typed attributes, whose elements have no index Terraform could point at
+var setAttributes = map[string]bool{
+	"environment_mappings":          true,
+	"build_mappings":                true,
+	"change_lead_time_issue_states": true,
+	"remote_types":                  true,
+}
+
+// attributePathFromField converts an API field such as `repository.owner`, `labels.0` or `labels[0]` to the
+// Terraform attribute path. Fields of an element of a set, such as `buildMappings.0.buildName`, point at the set.
+func attributePathFromField(field string, aliases fieldAliases) path.Path {
+	field = fieldIndexRegexp.ReplaceAllString(field, ".$1")
+
+	var p path.Path
+	var name string
+	for idx, segment := range strings.Split(field, ".") {
+		if segment == "" {
+			continue
+		}
+		if listIndex, err := strconv.Atoi(segment); err == nil && idx > 0 {
+			if setAttributes[name] {
+				return p
+			}
+			p = p.AtListIndex(listIndex)
+			continue
+		}
+		name = toSnakeCase(segment)
+		if alias, found := aliases[name]; found {
+			name = alias
+		}
+		if name == "" {
+			// the field has no attribute of its own, its fields are attributes of the parent
+			continue
+		}
+		if len(p.Steps()) == 0 {
+			p = path.Root(name)
+		} else {
+			p = p.AtName(name)
+		}
+	}
+	return p
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,98 @@
+package sleuth
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+func TestAttributePathFromField(t *testing.T) {
+	tests := []struct {
+		field    string
+		aliases  fieldAliases
+		expected path.Path
+	}{
+		{field: "name", expected: path.Root("name")},
+		{field: "repository.owner", expected: path.Root("repository").AtName("owner")},
+		{field: "labels.1", expected: path.Root("labels").AtListIndex(1)},
+		{field: "pathFilters.include[0]", expected: path.Root("path_filters").AtName("include").AtListIndex(0)},
+		{field: "buildMappings.0.buildName", expected: path.Root("build_mappings")},
+		{field: "build_mappings[1].build_name", expected: path.Root("build_mappings")},
+		{
+			field:    "buildMappings[2].buildProjectKey",
+			aliases:  codeChangeSourceFieldAliases,
+			expected: path.Root("build_mappings"),
+		},
+		{field: "environmentMappings.0", expected: path.Root("environment_mappings")},
+		{field: "cltStartDefinition", aliases: projectFieldAliases, expected: path.Root("change_lead_time_start_definition")},
+		{field: "auth", aliases: impactSourceFieldAliases, expected: path.Root("integration_slug")},
+		{
+			field:    "pagerDutyInput.remoteServices",
+			aliases:  incidentImpactSourceFieldAliases,
+			expected: path.Root("pagerduty_input").AtName("remote_services"),
+		},
+		{
+			field:    "pagerDutyInput.remoteServices",
+			aliases:  incidentProviderFieldAliases("pagerduty"),
+			expected: path.Root("remote_services"),
+		},
+		{
+			field:    "rootlyInput.remoteTeam",
+			aliases:  incidentProviderFieldAliases("rootly"),
+			expected: path.Root("remote_team"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			actual := attributePathFromField(tt.field, tt.aliases)
+			if !actual.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAddMutationErrorDiagnostics(t *testing.T) {
+	t.Run("field errors become attribute errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		err := fmt.Errorf("wrapped: %w", &gqlclient.MutationError{
+			Operation: "creating change source",
+			Errors: gqlclient.ErrorsType{
+				{Field: "repository.owner", Messages: []string{"Repository not found."}},
+				{Field: "__all__", Messages: []string{"Something went wrong."}},
+			},
+		})
+
+		addMutationErrorDiagnostics(&diags, "Error creating CodeChangeSource", "unused", err, nil)
+
+		if len(diags) != 2 {
+			t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+		}
+		withPath, ok := diags[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(path.Root("repository").AtName("owner")) {
+			t.Errorf("expected an attribute error at repository.owner, got %v", diags[0])
+		}
+		if diags[0].Detail() != "Repository not found." {
+			t.Errorf("unexpected detail %q", diags[0].Detail())
+		}
+		if _, ok := diags[1].(diag.DiagnosticWithPath); ok {
+			t.Errorf("expected a general error for __all__, got %v", diags[1])
+		}
+	})
+
+	t.Run("other errors are reported with the detail", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addMutationErrorDiagnostics(&diags, "Error creating Project", "Could not create project", errors.New("timeout"), nil)
+
+		if len(diags) != 1 || diags[0].Detail() != "Could not create project" {
+			t.Errorf("unexpected diagnostics %v", diags)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &environmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &environmentDataSource{}
+)
+
+type environmentDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewEnvironmentDataSource() datasource.DataSource {
+	return &environmentDataSource{}
+}
+
+func (p *environmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Description: "Environment data source looks up an existing environment of a project, either by slug or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_slug": schema.StringAttribute{
+				MarkdownDescription: "The project for this environment",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Environment slug. Exactly one of `slug` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Environment name. Exactly one of `slug` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Environment description",
+				Computed:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "The color for the UI",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (p *environmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (p *environmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (p *environmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "environment")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config envResourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Slug.IsNull() == config.Name.IsNull() {
+		res.Diagnostics.AddError(
+			"Invalid environment lookup",
+			"Exactly one of `slug` or `name` must be set to look up an environment",
+		)
+		return
+	}
+
+	projectSlug := config.ProjectSlug.ValueString()
+	tflog.Info(ctx, "Reading Environment data source", map[string]any{"projectSlug": projectSlug, "slug": config.Slug.ValueString(), "name": config.Name.ValueString()})
+
+	var env *gqlclient.Environment
+	var err error
+	lookup := config.Slug.ValueString()
+	if !config.Slug.IsNull() {
+		env, err = p.c.GetEnvironment(ctx, &projectSlug, &lookup)
+	} else {
+		lookup = config.Name.ValueString()
+		env, err = p.c.GetEnvironmentByName(ctx, &projectSlug, &lookup)
+	}
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError(
+			"Environment not found",
+			fmt.Sprintf("Could not find Environment %s in project %s", lookup, projectSlug),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error obtaining environment: %+v", err))
+		res.Diagnostics.AddError(
+			"Error Reading Environment",
+			fmt.Sprintf("Could not read Environment of project %s, %+v", projectSlug, err.Error()),
+		)
+		return
+	}
+
+	state := getNewStateFromEnv(env, projectSlug)
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,71 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEnvironmentDataSource_v6(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random name so slugs don't collide
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	name := fmt.Sprintf("Terraform test project %s", randomStr)
+	slug := fmt.Sprintf("terraform-test-project-%s", randomStr)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: environmentDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_environment.by_slug", "project_slug", slug),
+					resource.TestCheckResourceAttr("data.sleuth_environment.by_slug", "name", "staging"),
+					resource.TestCheckResourceAttr("data.sleuth_environment.by_slug", "description", "description abc"),
+					resource.TestCheckResourceAttr("data.sleuth_environment.by_slug", "color", "#ffffff"),
+
+					resource.TestCheckResourceAttr("data.sleuth_environment.by_name", "slug", "staging"),
+					resource.TestCheckResourceAttr("data.sleuth_environment.by_name", "color", "#ffffff"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "sleuth_environment" "invalid" {
+  project_slug = "%s"
+}
+`, slug),
+				ExpectError: regexp.MustCompile("Exactly one of `slug` or `name` must be set"),
+			},
+		},
+	})
+}
+
+func environmentDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_environment" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "staging"
+	description = "description abc"
+	color = "#ffffff"
+}
+
+data "sleuth_environment" "by_slug" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	slug = sleuth_environment.terraform_acc_test.slug
+}
+
+data "sleuth_environment" "by_name" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = sleuth_environment.terraform_acc_test.name
+}
+`, name)
+}
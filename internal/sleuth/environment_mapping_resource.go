@@ -0,0 +1,271 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ resource.Resource                = &environmentMappingResource{}
+	_ resource.ResourceWithConfigure   = &environmentMappingResource{}
+	_ resource.ResourceWithImportState = &environmentMappingResource{}
+)
+
+// errMappingNotFound is returned by mapping updates when the mapping is not in the code change source
+var errMappingNotFound = errors.New("mapping not found")
+
+type environmentMappingResourceModel struct {
+	ProjectSlug          types.String `tfsdk:"project_slug"`
+	CodeChangeSourceSlug types.String `tfsdk:"code_change_source_slug"`
+	environmentMappingsResourceModel
+}
+
+type environmentMappingResource struct {
+	c *gqlclient.Client
+}
+
+func NewEnvironmentMappingResource() resource.Resource {
+	return &environmentMappingResource{}
+}
+
+func (emr *environmentMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+	attributes := environmentMappingAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "`project_slug/code_change_source_slug/environment_slug`",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	environmentSlug := attributes["environment_slug"].(schema.StringAttribute)
+	environmentSlug.PlanModifiers = []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	attributes["environment_slug"] = environmentSlug
+	attributes["project_slug"] = schema.StringAttribute{
+		MarkdownDescription: "The slug of the project of the code change source",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["code_change_source_slug"] = schema.StringAttribute{
+		MarkdownDescription: "The slug of the code change source to add the mapping to",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Maps an environment to a branch of an existing code change source, for when the code change source and its " +
+			"environments are owned by different configurations. Set `ignore_external_mappings` on the `sleuth_code_change_source` " +
+			"so it leaves the mapping alone. " +
+			"Mappings are saved by replacing all mappings of the code change source. The code change source is read again after saving, " +
+			"and the mapping is saved again when an apply of another configuration undid it at the same time.",
+		Attributes: attributes,
+	}
+}
+
+func (emr *environmentMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	emr.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (emr *environmentMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_environment_mapping"
+}
+
+func (emr *environmentMappingResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "resource", "environment_mapping")
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan environmentMappingResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating EnvironmentMapping resource", map[string]any{"plan": plan})
+	environmentSlug := plan.EnvironmentSlug.ValueString()
+	ccs, err := emr.c.UpdateCodeChangeSourceMappings(ctx, plan.ProjectSlug.ValueString(), plan.CodeChangeSourceSlug.ValueString(), func(ccs *gqlclient.MutableCodeChangeSource) error {
+		if idx := findBranchMapping(ccs.EnvironmentMappings, environmentSlug); idx >= 0 {
+			return fmt.Errorf("environment %s is already mapped to branch %s, import the mapping instead", environmentSlug, ccs.EnvironmentMappings[idx].Branch)
+		}
+		ccs.EnvironmentMappings = append(ccs.EnvironmentMappings, gqlclient.BranchMapping{
+			EnvironmentSlug: environmentSlug,
+			Branch:          plan.Branch.ValueString(),
+		})
+		return nil
+	})
+	if err != nil {
+		tflog.Error(ctx, "Error creating EnvironmentMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error creating EnvironmentMapping", fmt.Sprintf("Could not create environment mapping: %+v", err.Error()))
+		return
+	}
+
+	state, found := getNewStateFromEnvironmentMapping(ccs, plan)
+	if !found {
+		res.Diagnostics.AddError("Error creating EnvironmentMapping", fmt.Sprintf("Environment mapping of %s was not saved", environmentSlug))
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+func (emr *environmentMappingResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "resource", "environment_mapping")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state environmentMappingResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	projectSlug := state.ProjectSlug.ValueString()
+	slug := state.CodeChangeSourceSlug.ValueString()
+	ccs, err := emr.c.GetCodeChangeSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "CodeChangeSource not found, removing EnvironmentMapping from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error reading EnvironmentMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error reading EnvironmentMapping", fmt.Sprintf("Could not read code change source %s/%s: %+v", projectSlug, slug, err.Error()))
+		return
+	}
+
+	newState, found := getNewStateFromEnvironmentMapping(ccs, state)
+	if !found {
+		tflog.Warn(ctx, "EnvironmentMapping not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, newState)...)
+}
+
+func (emr *environmentMappingResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "resource", "environment_mapping")
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan environmentMappingResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating EnvironmentMapping resource", map[string]any{"plan": plan})
+	environmentSlug := plan.EnvironmentSlug.ValueString()
+	ccs, err := emr.c.UpdateCodeChangeSourceMappings(ctx, plan.ProjectSlug.ValueString(), plan.CodeChangeSourceSlug.ValueString(), func(ccs *gqlclient.MutableCodeChangeSource) error {
+		idx := findBranchMapping(ccs.EnvironmentMappings, environmentSlug)
+		if idx < 0 {
+			return errMappingNotFound
+		}
+		ccs.EnvironmentMappings[idx].Branch = plan.Branch.ValueString()
+		// build mappings of the environment follow its branch
+		ccs.SetBuildBranches()
+		return nil
+	})
+	if err != nil {
+		tflog.Error(ctx, "Error updating EnvironmentMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error updating EnvironmentMapping", fmt.Sprintf("Could not update environment mapping of %s: %+v", environmentSlug, err.Error()))
+		return
+	}
+
+	state, found := getNewStateFromEnvironmentMapping(ccs, plan)
+	if !found {
+		res.Diagnostics.AddError("Error updating EnvironmentMapping", fmt.Sprintf("Environment mapping of %s was not saved", environmentSlug))
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+func (emr *environmentMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "resource", "environment_mapping")
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state environmentMappingResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting EnvironmentMapping resource", map[string]any{"state": state})
+	environmentSlug := state.EnvironmentSlug.ValueString()
+	_, err := emr.c.UpdateCodeChangeSourceMappings(ctx, state.ProjectSlug.ValueString(), state.CodeChangeSourceSlug.ValueString(), func(ccs *gqlclient.MutableCodeChangeSource) error {
+		idx := findBranchMapping(ccs.EnvironmentMappings, environmentSlug)
+		if idx < 0 {
+			return errMappingNotFound
+		}
+		for _, bm := range ccs.BuildMappings {
+			if bm.EnvironmentSlug == environmentSlug {
+				return fmt.Errorf("build %s is still mapped to environment %s, remove its build mappings first", bm.BuildName, environmentSlug)
+			}
+		}
+		ccs.EnvironmentMappings = slices.Delete(ccs.EnvironmentMappings, idx, idx+1)
+		return nil
+	})
+	if errors.Is(err, gqlclient.ErrNotFound) || errors.Is(err, errMappingNotFound) {
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error deleting EnvironmentMapping", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError("Error deleting EnvironmentMapping", fmt.Sprintf("Could not delete environment mapping of %s: %+v", environmentSlug, err.Error()))
+	}
+}
+
+func (emr *environmentMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	// environment mappings are imported by `project_slug/code_change_source_slug/environment_slug`
+	parts, err := parseMappingImportID(req.ID, "project_slug", "code_change_source_slug", "environment_slug")
+	if err != nil {
+		res.Diagnostics.AddError("Error importing EnvironmentMapping", err.Error())
+		return
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("code_change_source_slug"), parts[1])...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("environment_slug"), parts[2])...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// findBranchMapping returns the index of the mapping of environmentSlug, or -1
+func findBranchMapping(mappings []gqlclient.BranchMapping, environmentSlug string) int {
+	return slices.IndexFunc(mappings, func(em gqlclient.BranchMapping) bool {
+		return em.EnvironmentSlug == environmentSlug
+	})
+}
+
+// getNewStateFromEnvironmentMapping returns the state of the mapping of the environment of prior in ccs, and false
+// if ccs does not map it
+func getNewStateFromEnvironmentMapping(ccs *gqlclient.CodeChangeSource, prior environmentMappingResourceModel) (environmentMappingResourceModel, bool) {
+	idx := findBranchMapping(ccs.EnvironmentMappings, prior.EnvironmentSlug.ValueString())
+	if idx < 0 {
+		return environmentMappingResourceModel{}, false
+	}
+
+	em := ccs.EnvironmentMappings[idx]
+	projectSlug := prior.ProjectSlug.ValueString()
+	return environmentMappingResourceModel{
+		ProjectSlug:          types.StringValue(projectSlug),
+		CodeChangeSourceSlug: types.StringValue(ccs.Slug),
+		environmentMappingsResourceModel: environmentMappingsResourceModel{
+			EnvironmentSlug: types.StringValue(em.EnvironmentSlug),
+			Branch:          types.StringValue(em.Branch),
+			ID:              types.StringValue(fmt.Sprintf("%s/%s/%s", projectSlug, ccs.Slug, em.EnvironmentSlug)),
+		},
+	}, true
+}
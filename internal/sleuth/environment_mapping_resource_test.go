@@ -0,0 +1,108 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEnvironmentMappingResource(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectName := fmt.Sprintf("Terraform test project %s", randomStr)
+	projectSlug := fmt.Sprintf("terraform-test-project-%s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: environmentMappingConfig(projectName, "Terraform code change source", "develop"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_environment_mapping.staging", "id", fmt.Sprintf("%s/terraform-code-change-source/staging", projectSlug)),
+					resource.TestCheckResourceAttr("sleuth_environment_mapping.staging", "branch", "develop"),
+					// the mapping is left out of the code change source, which ignores external mappings
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.#", "1"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "environment_mappings.0.environment_slug", "production"),
+				),
+			},
+			// Updating the code change source and the mapping together keeps both changes
+			{
+				Config: environmentMappingConfig(projectName, "Terraform code change source updated", "release"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_environment_mapping.staging", "branch", "release"),
+					resource.TestCheckResourceAttr("sleuth_code_change_source.terraform_acc_test", "name", "Terraform code change source updated"),
+				),
+			},
+			{
+				Config: environmentMappingConfig(projectName, "Terraform code change source updated", "release"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      "sleuth_environment_mapping.staging",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: environmentMappingConfig(projectName, "Terraform code change source updated", "release") + `
+resource "sleuth_environment_mapping" "production" {
+	project_slug            = sleuth_project.terraform_acc_test.slug
+	code_change_source_slug = sleuth_code_change_source.terraform_acc_test.slug
+	environment_slug        = "production"
+	branch                  = "main"
+}
+`,
+				ExpectError: regexp.MustCompile(`already\s+mapped\s+to\s+branch\s+main`),
+			},
+		},
+	})
+}
+
+func environmentMappingConfig(projectName, sourceName, branch string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_environment" "staging" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "staging"
+}
+
+resource "sleuth_code_change_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "%s"
+	repository = {
+		name = "terraform-provider-sleuth"
+		owner = "sleuth-io"
+		provider = "GITHUB"
+		url = "https://github.com/sleuth-io/terraform-provider-sleuth"
+	}
+	ignore_external_mappings = true
+	environment_mappings = [
+		{
+			environment_slug = "production"
+			branch = "main"
+		}
+	]
+	deploy_tracking_type = "manual"
+}
+
+resource "sleuth_environment_mapping" "staging" {
+	project_slug            = sleuth_project.terraform_acc_test.slug
+	code_change_source_slug = sleuth_code_change_source.terraform_acc_test.slug
+	environment_slug        = sleuth_environment.staging.slug
+	branch                  = "%s"
+}
+`, projectName, sourceName, branch)
+}
@@ -2,17 +2,18 @@ package sleuth
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/validators"
 )
 
 var (
@@ -64,10 +65,13 @@ func (p *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Default:             stringdefault.StaticString(""),
 			},
 			"color": schema.StringAttribute{
-				MarkdownDescription: "The color for the UI",
+				MarkdownDescription: "The color for the UI, as a hex value such as `#cecece`",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("#cecece"),
+				Validators: []validator.String{
+					validators.HexColor(),
+				},
 			},
 		},
 	}
@@ -103,7 +107,7 @@ func (p *environmentResource) Create(ctx context.Context, req resource.CreateReq
 	// We create the environment automatically when Project is created, so we need to check if it already exists
 	existingEnv, err := p.c.GetEnvironmentByName(ctx, &projectSlug, &envName)
 
-	if err != nil && err != gqlclient.ErrNotFound {
+	if err != nil && !errors.Is(err, gqlclient.ErrNotFound) {
 		res.Diagnostics.AddError("Error obtaining environment", fmt.Sprintf("Could not obtain environment, unexpected error: %+v", err.Error()))
 		return
 	}
@@ -114,20 +118,14 @@ func (p *environmentResource) Create(ctx context.Context, req resource.CreateReq
 		env, err = p.c.UpdateEnvironment(ctx, input)
 		if err != nil {
 			tflog.Error(ctx, "Error updating Environment", map[string]any{"error": err.Error()})
-			res.Diagnostics.AddError(
-				"Error creating Environment",
-				fmt.Sprintf("Could not create environment, unexpected error: %+v", err.Error()),
-			)
+			addMutationErrorDiagnostics(&res.Diagnostics, "Error creating Environment", fmt.Sprintf("Could not create environment, unexpected error: %+v", err.Error()), err, nil)
 			return
 		}
 	} else {
 		env, err = p.c.CreateEnvironment(ctx, input)
 		if err != nil {
 			tflog.Error(ctx, "Error creating Environment", map[string]any{"error": err.Error()})
-			res.Diagnostics.AddError(
-				"Error creating Environment",
-				fmt.Sprintf("Could not create environment, unexpected error: %+v", err.Error()),
-			)
+			addMutationErrorDiagnostics(&res.Diagnostics, "Error creating Environment", fmt.Sprintf("Could not create environment, unexpected error: %+v", err.Error()), err, nil)
 			return
 		}
 	}
@@ -149,13 +147,6 @@ func (p *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 
 	projectSlug := state.ProjectSlug.ValueString()
 	slug := state.Slug.ValueString()
-	// when importing a resource, only ID will be set with project slug & slug
-	if projectSlug == "" {
-		id := state.ID.ValueString()
-		splits := strings.Split(id, "/")
-		projectSlug = splits[0]
-		slug = splits[1]
-	}
 
 	tflog.Info(ctx, "Refreshing Environment resource", map[string]any{"state": fmt.Sprintf("%+v", state)})
 	res.Diagnostics.Append(diags...)
@@ -165,6 +156,11 @@ func (p *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	env, err := p.c.GetEnvironment(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "Environment not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error obtaining environment: %+v", err))
 		res.Diagnostics.AddError(
@@ -173,10 +169,6 @@ func (p *environmentResource) Read(ctx context.Context, req resource.ReadRequest
 		)
 		return
 	}
-	if env == nil {
-		return
-	}
-
 	newState := getNewStateFromEnv(env, projectSlug)
 
 	diags = res.State.Set(ctx, &newState)
@@ -209,7 +201,7 @@ func (p *environmentResource) Update(ctx context.Context, req resource.UpdateReq
 
 	env, err := p.c.UpdateEnvironment(ctx, input)
 	if err != nil {
-		diags.AddError("Error updating environment", err.Error())
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating environment", err.Error(), err, nil)
 		return
 	}
 
@@ -241,7 +233,14 @@ func (p *environmentResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (p *environmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	// environments are imported by `project_slug/slug` or `project_slug/name:<Name>`
+	importProjectScopedResource(ctx, req, res, "Environment", func(ctx context.Context, projectSlug, name string) (string, error) {
+		env, err := p.c.GetEnvironmentByName(ctx, &projectSlug, &name)
+		if err != nil {
+			return "", err
+		}
+		return env.Slug, nil
+	})
 }
 
 func getNewStateFromEnv(env *gqlclient.Environment, projectSlug string) envResourceModel {
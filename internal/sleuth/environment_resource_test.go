@@ -2,6 +2,7 @@ package sleuth
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -22,6 +23,20 @@ func TestAccEnvironmentResource_v6(t *testing.T) {
 			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
 		},
 		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_environment" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	name = "staging"
+	color = "grey"
+}
+`, name),
+				ExpectError: regexp.MustCompile(`color value must be a hex color such as .#cecece. or .#ccc., got: "grey"`),
+			},
 			// Create and Read testing
 			{
 				Config: createEnvConfig(name),
@@ -49,6 +64,19 @@ func TestAccEnvironmentResource_v6(t *testing.T) {
 					resource.TestCheckResourceAttr("sleuth_environment.terraform_acc_test", "color", "#ffffff"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "sleuth_environment.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_environment.terraform_acc_test"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sleuth_environment.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDByName("sleuth_environment.terraform_acc_test"),
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,129 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &errorImpactSourceDataSource{}
+	_ datasource.DataSourceWithConfigure = &errorImpactSourceDataSource{}
+)
+
+type errorImpactSourceDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewErrorImpactSourceDataSource() datasource.DataSource {
+	return &errorImpactSourceDataSource{}
+}
+
+func (eisd *errorImpactSourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Sleuth error impact source by project and slug.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project that this error impact source belongs to.",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Error impact source slug",
+				Required:            true,
+			},
+			"environment_slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the environment that this error impact source belongs to.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Error impact source name",
+				Computed:            true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Integration provider type",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"error_org_key": schema.StringAttribute{
+				MarkdownDescription: "The organization key of the integration provider",
+				Computed:            true,
+			},
+			"error_project_key": schema.StringAttribute{
+				MarkdownDescription: "The project key of the integration provider",
+				Computed:            true,
+			},
+			"error_environment": schema.StringAttribute{
+				MarkdownDescription: "The environment of the integration provider",
+				Computed:            true,
+			},
+			"manually_set_health_threshold": schema.Float64Attribute{
+				MarkdownDescription: "The manually set threshold to start marking failed values",
+				Computed:            true,
+			},
+			"integration_slug": schema.StringAttribute{
+				MarkdownDescription: "The integration slug",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (eisd *errorImpactSourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	eisd.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (eisd *errorImpactSourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_error_impact_source"
+}
+
+func (eisd *errorImpactSourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "error_impact_source")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config errorImpactResourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	projectSlug := config.ProjectSlug.ValueString()
+	slug := config.Slug.ValueString()
+	tflog.Info(ctx, "Reading ErrorImpactSource data source", map[string]any{"projectSlug": projectSlug, "slug": slug})
+
+	eis, err := eisd.c.GetErrorImpactSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError(
+			"ErrorImpactSource not found",
+			fmt.Sprintf("Could not find error impact source %s in project %s", slug, projectSlug),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error reading ErrorImpactSource", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError(
+			"Error reading ErrorImpactSource",
+			fmt.Sprintf("Could not read error impact source %s/%s: %+v", projectSlug, slug, err.Error()),
+		)
+		return
+	}
+
+	state := getNewStateFromErrorImpactSource(eis, projectSlug)
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
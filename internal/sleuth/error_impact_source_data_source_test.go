@@ -0,0 +1,44 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccErrorImpactSourceDataSource_v6(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random name so slugs don't collide
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: errorImpactSourceDataSourceConfig(projectString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_error_impact_source.terraform_acc_test", "name", "Sentry errors"),
+					resource.TestMatchResourceAttr("data.sleuth_error_impact_source.terraform_acc_test", "provider_type", regexp.MustCompile(`(?i)^SENTRY$`)),
+					resource.TestCheckResourceAttr("data.sleuth_error_impact_source.terraform_acc_test", "error_environment", "prod"),
+					resource.TestCheckResourceAttrPair("data.sleuth_error_impact_source.terraform_acc_test", "environment_slug", "sleuth_environment.terraform_acc_test", "slug"),
+				),
+			},
+		},
+	})
+}
+
+func errorImpactSourceDataSourceConfig(name string) string {
+	return createErrorImpactSourceConfig(name) + `
+data "sleuth_error_impact_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	slug = sleuth_error_impact_source.sentry_terraform_acc_test.slug
+}
+`
+}
@@ -2,26 +2,31 @@ package sleuth
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/validators"
 )
 
 var (
 	_ resource.Resource                = &errorImpactSourceResource{}
 	_ resource.ResourceWithConfigure   = &errorImpactSourceResource{}
 	_ resource.ResourceWithImportState = &errorImpactSourceResource{}
+	_ resource.ResourceWithModifyPlan  = &errorImpactSourceResource{}
 )
 
+var errorProviders = []string{"SENTRY", "ROLLBAR", "BUGSNAG", "HONEYBADGER"}
+
 type errorImpactResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Slug types.String `tfsdk:"slug"`
@@ -29,13 +34,13 @@ type errorImpactResourceModel struct {
 	ProjectSlug     types.String `tfsdk:"project_slug"`
 	EnvironmentSlug types.String `tfsdk:"environment_slug"`
 
-	Name                       types.String  `tfsdk:"name"`
-	ProviderType               types.String  `tfsdk:"provider_type"`
-	ErrorOrgKey                types.String  `tfsdk:"error_org_key"`
-	ErrorProjectKey            types.String  `tfsdk:"error_project_key"`
-	ErrorEnvironment           types.String  `tfsdk:"error_environment"`
-	ManuallySetHealthThreshold types.Float64 `tfsdk:"manually_set_health_threshold"`
-	IntegrationSlug            types.String  `tfsdk:"integration_slug"`
+	Name                       types.String               `tfsdk:"name"`
+	ProviderType               caseInsensitiveStringValue `tfsdk:"provider_type"`
+	ErrorOrgKey                types.String               `tfsdk:"error_org_key"`
+	ErrorProjectKey            types.String               `tfsdk:"error_project_key"`
+	ErrorEnvironment           types.String               `tfsdk:"error_environment"`
+	ManuallySetHealthThreshold types.Float64              `tfsdk:"manually_set_health_threshold"`
+	IntegrationSlug            types.String               `tfsdk:"integration_slug"`
 }
 
 type errorImpactSourceResource struct {
@@ -72,8 +77,12 @@ func (eisr *errorImpactSourceResource) Schema(_ context.Context, _ resource.Sche
 				Required:            true,
 			},
 			"provider_type": schema.StringAttribute{
-				MarkdownDescription: "Integration provider type",
+				MarkdownDescription: "Integration provider type - options: SENTRY, ROLLBAR, BUGSNAG, HONEYBADGER.",
+				CustomType:          caseInsensitiveStringType{},
 				Required:            true,
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive(errorProviders...),
+				},
 			},
 			"error_org_key": schema.StringAttribute{
 				MarkdownDescription: "The organization key of the integration provider",
@@ -112,6 +121,24 @@ func (eisr *errorImpactSourceResource) Metadata(ctx context.Context, req resourc
 	res.TypeName = req.ProviderTypeName + "_error_impact_source"
 }
 
+// ModifyPlan fails the plan when the project, environment or integration of the source does not exist, see
+// validate_references_on_plan
+func (eisr *errorImpactSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	references, ok := newReferenceValidator(eisr.c, req, res)
+	if !ok {
+		return
+	}
+	var plan errorImpactResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	if references.project(ctx, path.Root("project_slug"), plan.ProjectSlug) {
+		references.environment(ctx, path.Root("environment_slug"), plan.ProjectSlug.ValueString(), plan.EnvironmentSlug)
+	}
+	references.integration(ctx, path.Root("integration_slug"), plan.IntegrationSlug)
+}
+
 func (eisr *errorImpactSourceResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
 	ctx = tflog.SetField(ctx, "resource", "error_impact_source")
 	ctx = tflog.SetField(ctx, "operation", "create")
@@ -138,10 +165,7 @@ func (eisr *errorImpactSourceResource) Create(ctx context.Context, req resource.
 	eis, err := eisr.c.CreateErrorImpactSource(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error creating ErrorImpactSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error creating ErrorImpactSource",
-			fmt.Sprintf("Could not create error impact source, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating ErrorImpactSource", fmt.Sprintf("Could not create error impact source, unexpected error: %+v", err.Error()), err, impactSourceFieldAliases)
 		return
 	}
 
@@ -164,15 +188,12 @@ func (eisr *errorImpactSourceResource) Read(ctx context.Context, req resource.Re
 	projectSlug := state.ProjectSlug.ValueString()
 	slug := state.Slug.ValueString()
 
-	// when importing a resource, only ID will be set with project slug & slug
-	if projectSlug == "" {
-		id := state.ID.ValueString()
-		splits := strings.Split(id, "/")
-		projectSlug = splits[0]
-		slug = splits[1]
-	}
-
 	eis, err := eisr.c.GetErrorImpactSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "ErrorImpactSource not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		tflog.Error(ctx, "Error reading ErrorImpactSource", map[string]any{"error": err.Error()})
 		res.Diagnostics.AddError(
@@ -219,10 +240,7 @@ func (eisr *errorImpactSourceResource) Update(ctx context.Context, req resource.
 	eis, err := eisr.c.UpdateErrorImpactSource(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error updating ErrorImpactSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error updating ErrorImpactSource",
-			fmt.Sprintf("Could not update error impact source, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating ErrorImpactSource", fmt.Sprintf("Could not update error impact source, unexpected error: %+v", err.Error()), err, impactSourceFieldAliases)
 		return
 	}
 
@@ -259,7 +277,14 @@ func (eisr *errorImpactSourceResource) Delete(ctx context.Context, req resource.
 }
 
 func (eisr *errorImpactSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	// error impact sources are imported by `project_slug/slug` or `project_slug/name:<Name>`
+	importProjectScopedResource(ctx, req, res, "ErrorImpactSource", func(ctx context.Context, projectSlug, name string) (string, error) {
+		eis, err := eisr.c.GetErrorImpactSourceByName(ctx, &projectSlug, &name)
+		if err != nil {
+			return "", err
+		}
+		return eis.Slug, nil
+	})
 }
 
 func getNewStateFromErrorImpactSource(eis *gqlclient.ErrorImpactSource, projectSlug string) errorImpactResourceModel {
@@ -269,7 +294,7 @@ func getNewStateFromErrorImpactSource(eis *gqlclient.ErrorImpactSource, projectS
 		ProjectSlug:                types.StringValue(projectSlug),
 		EnvironmentSlug:            types.StringValue(eis.Environment.Slug),
 		Name:                       types.StringValue(eis.Name),
-		ProviderType:               types.StringValue(strings.ToUpper(eis.Provider)),
+		ProviderType:               newCaseInsensitiveStringValue(eis.Provider),
 		ErrorOrgKey:                types.StringValue(eis.ErrorOrgKey),
 		ErrorProjectKey:            types.StringValue(eis.ErrorProjectKey),
 		ErrorEnvironment:           types.StringValue(eis.ErrorEnvironment),
@@ -283,7 +308,7 @@ func getMutableErrorImpactSourceStruct(plan errorImpactResourceModel) *gqlclient
 	return &gqlclient.MutableErrorImpactSource{
 		EnvironmentSlug:            plan.EnvironmentSlug.ValueString(),
 		Name:                       plan.Name.ValueString(),
-		Provider:                   plan.ProviderType.ValueString(),
+		Provider:                   plan.ProviderType.upper(),
 		ErrorOrgKey:                plan.ErrorOrgKey.ValueString(),
 		ErrorProjectKey:            plan.ErrorProjectKey.ValueString(),
 		ErrorEnvironment:           plan.ErrorEnvironment.ValueString(),
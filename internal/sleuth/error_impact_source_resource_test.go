@@ -56,6 +56,19 @@ func TestAccErrorImpactSourceResource_v6(t *testing.T) {
 					resource.TestCheckResourceAttr("sleuth_error_impact_source.sentry_terraform_acc_test", "manually_set_health_threshold", "5"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "sleuth_error_impact_source.sentry_terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_error_impact_source.sentry_terraform_acc_test"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sleuth_error_impact_source.sentry_terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDByName("sleuth_error_impact_source.sentry_terraform_acc_test"),
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
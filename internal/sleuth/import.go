@@ -0,0 +1,89 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// importNamePrefix marks the second part of an import ID as a name to look the slug up by
+const importNamePrefix = "name:"
+
+// projectScopedImportID is a parsed import ID of a resource that belongs to a project
+type projectScopedImportID struct {
+	ProjectSlug string
+	Slug        string
+	Name        string
+}
+
+// parseProjectScopedImportID parses an import ID of the form `project_slug/slug` or `project_slug/name:<Name>`.
+// Names may contain slashes, only the first one separates the project slug.
+func parseProjectScopedImportID(id string) (projectScopedImportID, error) {
+	projectSlug, rest, found := strings.Cut(id, "/")
+	if !found || projectSlug == "" || rest == "" {
+		return projectScopedImportID{}, fmt.Errorf("expected an import ID of the form 'project_slug/slug' or 'project_slug/name:<Name>', got %q", id)
+	}
+
+	if name, ok := strings.CutPrefix(rest, importNamePrefix); ok {
+		if name == "" {
+			return projectScopedImportID{}, fmt.Errorf("expected a name after %q in import ID %q", importNamePrefix, id)
+		}
+		return projectScopedImportID{ProjectSlug: projectSlug, Name: name}, nil
+	}
+	if strings.Contains(rest, "/") {
+		return projectScopedImportID{}, fmt.Errorf("expected an import ID of the form 'project_slug/slug' or 'project_slug/name:<Name>', got %q", id)
+	}
+	return projectScopedImportID{ProjectSlug: projectSlug, Slug: rest}, nil
+}
+
+// importProjectScopedResource sets project_slug, slug and id from the import ID, so Read can refresh the resource.
+// slugByName resolves the slug of the `project_slug/name:<Name>` form.
+func importProjectScopedResource(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse, resourceName string, slugByName func(ctx context.Context, projectSlug, name string) (string, error)) {
+	importID, err := parseProjectScopedImportID(req.ID)
+	if err != nil {
+		res.Diagnostics.AddError(fmt.Sprintf("Error importing %s", resourceName), err.Error())
+		return
+	}
+
+	slug := importID.Slug
+	if importID.Name != "" {
+		tflog.Info(ctx, fmt.Sprintf("Looking up %s by name", resourceName), map[string]any{"projectSlug": importID.ProjectSlug, "name": importID.Name})
+		slug, err = slugByName(ctx, importID.ProjectSlug, importID.Name)
+		if errors.Is(err, gqlclient.ErrNotFound) {
+			res.Diagnostics.AddError(
+				fmt.Sprintf("Error importing %s", resourceName),
+				fmt.Sprintf("Could not find %s named %q in project %s", resourceName, importID.Name, importID.ProjectSlug),
+			)
+			return
+		}
+		if err != nil {
+			res.Diagnostics.AddError(
+				fmt.Sprintf("Error importing %s", resourceName),
+				fmt.Sprintf("Could not look up %s named %q in project %s, unexpected error: %+v", resourceName, importID.Name, importID.ProjectSlug, err.Error()),
+			)
+			return
+		}
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("project_slug"), importID.ProjectSlug)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("slug"), slug)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), slug)...)
+}
+
+// parseMappingImportID splits an import ID of the form `project_slug/code_change_source_slug/...` into the named parts.
+// Only the last part may contain slashes.
+func parseMappingImportID(id string, parts ...string) ([]string, error) {
+	values := strings.SplitN(id, "/", len(parts))
+	if len(values) != len(parts) || slices.Contains(values, "") {
+		return nil, fmt.Errorf("expected an import ID of the form '%s', got %q", strings.Join(parts, "/"), id)
+	}
+	return values, nil
+}
@@ -0,0 +1,74 @@
+package sleuth
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseProjectScopedImportID(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected projectScopedImportID
+		err      bool
+	}{
+		{id: "payments/production", expected: projectScopedImportID{ProjectSlug: "payments", Slug: "production"}},
+		{id: "payments/name:Production", expected: projectScopedImportID{ProjectSlug: "payments", Name: "Production"}},
+		{id: "payments/name:Deploys to EU/US", expected: projectScopedImportID{ProjectSlug: "payments", Name: "Deploys to EU/US"}},
+		{id: "production", err: true},
+		{id: "/production", err: true},
+		{id: "payments/", err: true},
+		{id: "payments/name:", err: true},
+		{id: "payments/production/eu", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			importID, err := parseProjectScopedImportID(tt.id)
+			if tt.err {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", importID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if importID != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, importID)
+			}
+		})
+	}
+}
+
+func TestParseMappingImportID(t *testing.T) {
+	parts := []string{"project_slug", "code_change_source_slug", "environment_slug", "build_name"}
+	tests := []struct {
+		id       string
+		expected []string
+		err      bool
+	}{
+		{id: "payments/api/production/release", expected: []string{"payments", "api", "production", "release"}},
+		{id: "payments/api/production/deploy/eu", expected: []string{"payments", "api", "production", "deploy/eu"}},
+		{id: "payments/api/production", err: true},
+		{id: "payments/api//release", err: true},
+		{id: "payments/api/production/", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			values, err := parseMappingImportID(tt.id, parts...)
+			if tt.err {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", values)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(values, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, values)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &incidentImpactSourceDataSource{}
+	_ datasource.DataSourceWithConfigure = &incidentImpactSourceDataSource{}
+)
+
+type incidentImpactSourceDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewIncidentImpactSourceDataSource() datasource.DataSource {
+	return &incidentImpactSourceDataSource{}
+}
+
+func (iisd *incidentImpactSourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Sleuth incident impact source by project and slug. Only the input block matching `provider_name` is populated.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project that this incident impact source belongs to.",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Incident impact source slug",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Impact source name",
+				Computed:            true,
+			},
+			"provider_name": schema.StringAttribute{
+				MarkdownDescription: "Impact source provider",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"environment_name": schema.StringAttribute{
+				MarkdownDescription: "Impact source environment name",
+				Computed:            true,
+			},
+			"pagerduty_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "PagerDuty input",
+				Attributes: map[string]schema.Attribute{
+					"remote_services": schema.StringAttribute{
+						MarkdownDescription: "List of remote services, empty string means all",
+						Computed:            true,
+					},
+					"remote_urgency": schema.StringAttribute{
+						MarkdownDescription: "PagerDuty remote urgency",
+						CustomType:          caseInsensitiveStringType{},
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "IntegrationAuthentication slug used",
+						Computed:            true,
+					},
+				},
+			},
+			"datadog_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "DataDog input",
+				Attributes: map[string]schema.Attribute{
+					"query": schema.StringAttribute{
+						MarkdownDescription: "The query to scope the monitors to track",
+						Computed:            true,
+					},
+					"remote_priority_threshold": schema.StringAttribute{
+						MarkdownDescription: "Monitor states with matching or higher priorities will be considered a failure in Sleuth",
+						CustomType:          caseInsensitiveStringType{},
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "DataDog IntegrationAuthentication slug from app",
+						Computed:            true,
+					},
+				},
+			},
+			"jira_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "JIRA input",
+				Attributes: map[string]schema.Attribute{
+					"remote_jql": schema.StringAttribute{
+						MarkdownDescription: "JIRA active incidents issues JQL",
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "JIRA IntegrationAuthentication slug from app",
+						Computed:            true,
+					},
+				},
+			},
+			"blameless_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Blameless input",
+				Attributes: map[string]schema.Attribute{
+					"remote_types": schema.SetAttribute{
+						ElementType:         basetypes.StringType{},
+						MarkdownDescription: "The types of incidents to the monitors should track",
+						Computed:            true,
+					},
+					"remote_severity_threshold": schema.StringAttribute{
+						MarkdownDescription: "Incidents with matching or lower severities will be considered a failure in Sleuth",
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "Blameless IntegrationAuthentication slug from app",
+						Computed:            true,
+					},
+				},
+			},
+			"statuspage_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Statuspage input",
+				Attributes: map[string]schema.Attribute{
+					"remote_page": schema.StringAttribute{
+						MarkdownDescription: "Statuspage page the incident impact source monitors",
+						Computed:            true,
+					},
+					"remote_component": schema.StringAttribute{
+						MarkdownDescription: "Statuspage component the incident impact source monitors",
+						Computed:            true,
+					},
+					"remote_impact": schema.StringAttribute{
+						MarkdownDescription: "Incidents with matching or lower severities will be considered a failure in Sleuth",
+						Computed:            true,
+					},
+					"ignore_maintenance_incidents": schema.BoolAttribute{
+						MarkdownDescription: "Whether maintenance incidents are ignored",
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "Statuspage IntegrationAuthentication slug from app",
+						Computed:            true,
+					},
+				},
+			},
+			"opsgenie_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "OpsGenie input",
+				Attributes: map[string]schema.Attribute{
+					"remote_alert_tags": schema.StringAttribute{
+						MarkdownDescription: "Alert tags filter",
+						Computed:            true,
+					},
+					"remote_incident_tags": schema.StringAttribute{
+						MarkdownDescription: "Incident tags filter",
+						Computed:            true,
+					},
+					"remote_priority_threshold": schema.StringAttribute{
+						MarkdownDescription: "Monitor states with matching or higher priorities will be considered a failure in Sleuth",
+						CustomType:          caseInsensitiveStringType{},
+						Computed:            true,
+					},
+					"remote_service": schema.StringAttribute{
+						MarkdownDescription: "Unique ID of the OpsGenie service",
+						Computed:            true,
+					},
+					"remote_use_alerts": schema.BoolAttribute{
+						MarkdownDescription: "Whether OpsGenie Alerts are used instead of Incidents",
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "The slug for the integration",
+						Computed:            true,
+					},
+				},
+			},
+			"firehydrant_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "FireHydrant input",
+				Attributes: map[string]schema.Attribute{
+					"remote_environments": schema.StringAttribute{
+						MarkdownDescription: "The environment defined in FireHydrant to monitor",
+						Computed:            true,
+					},
+					"remote_services": schema.StringAttribute{
+						MarkdownDescription: "The service defined in FireHydrant to monitor",
+						Computed:            true,
+					},
+					"remote_mitigated_is_healthy": schema.BoolAttribute{
+						MarkdownDescription: "If true, incident considered to have ended once reaching mitigated Milestone or it is resolved",
+						Computed:            true,
+					},
+				},
+			},
+			"clubhouse_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Clubhouse input",
+				Attributes: map[string]schema.Attribute{
+					"remote_query": schema.StringAttribute{
+						MarkdownDescription: "Shortcut search query expression",
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "IntegrationAuthentication slug used",
+						Computed:            true,
+					},
+				},
+			},
+			"rootly_input": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Rootly input",
+				Attributes: map[string]schema.Attribute{
+					"remote_severity": schema.StringAttribute{
+						MarkdownDescription: "Rootly severity",
+						Computed:            true,
+					},
+					"remote_incident_type": schema.StringAttribute{
+						MarkdownDescription: "Incident type ID (incident types are defined within Rootly)",
+						Computed:            true,
+					},
+					"remote_environment": schema.StringAttribute{
+						MarkdownDescription: "Environment ID (environments are defined within Rootly)",
+						Computed:            true,
+					},
+					"remote_service": schema.StringAttribute{
+						MarkdownDescription: "Service ID (services are defined within Rootly)",
+						Computed:            true,
+					},
+					"remote_team": schema.StringAttribute{
+						MarkdownDescription: "Team ID (teams are defined within Rootly)",
+						Computed:            true,
+					},
+					"integration_slug": schema.StringAttribute{
+						MarkdownDescription: "IntegrationAuthentication slug used",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (iisd *incidentImpactSourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	iisd.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (iisd *incidentImpactSourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_incident_impact_source"
+}
+
+func (iisd *incidentImpactSourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "incident_impact_source")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config incidentImpactResourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	projectSlug := config.ProjectSlug.ValueString()
+	slug := config.Slug.ValueString()
+	tflog.Info(ctx, "Reading IncidentImpactSource data source", map[string]any{"projectSlug": projectSlug, "slug": slug})
+
+	iis, err := iisd.c.GetIncidentImpactSource(ctx, projectSlug, slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError(
+			"IncidentImpactSource not found",
+			fmt.Sprintf("Could not find incident impact source %s in project %s", slug, projectSlug),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error reading IncidentImpactSource", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError(
+			"Error reading IncidentImpactSource",
+			fmt.Sprintf("Could not read incident impact source %s/%s: %+v", projectSlug, slug, err.Error()),
+		)
+		return
+	}
+
+	state, diags := getNewStateFromIncidentImpactSource(ctx, iis, projectSlug)
+	res.Diagnostics.Append(diags...)
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
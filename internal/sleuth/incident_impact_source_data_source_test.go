@@ -0,0 +1,45 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIncidentImpactSourceDataSource_v6(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random name so slugs don't collide
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version1_3_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: incidentImpactSourceDataSourceConfig(projectString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_incident_impact_source.terraform_acc_test", "name", "DataDog TF incident impact"),
+					resource.TestMatchResourceAttr("data.sleuth_incident_impact_source.terraform_acc_test", "provider_name", regexp.MustCompile(`(?i)^datadog$`)),
+					resource.TestCheckResourceAttr("data.sleuth_incident_impact_source.terraform_acc_test", "datadog_input.query", "@query=1234"),
+					resource.TestCheckResourceAttr("data.sleuth_incident_impact_source.terraform_acc_test", "datadog_input.remote_priority_threshold", "P4"),
+					resource.TestCheckNoResourceAttr("data.sleuth_incident_impact_source.terraform_acc_test", "jira_input.remote_jql"),
+				),
+			},
+		},
+	})
+}
+
+func incidentImpactSourceDataSourceConfig(name string) string {
+	return createIncidentImpactConfig(name) + `
+data "sleuth_incident_impact_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	slug = sleuth_incident_impact_source.terraform_acc_test_dd.slug
+}
+`
+}
@@ -2,7 +2,9 @@ package sleuth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,17 +16,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/validators"
 )
 
 var (
-	_ resource.Resource                = &incidentImpactSourceResource{}
-	_ resource.ResourceWithConfigure   = &incidentImpactSourceResource{}
-	_ resource.ResourceWithImportState = &incidentImpactSourceResource{}
+	_ resource.Resource                   = &incidentImpactSourceResource{}
+	_ resource.ResourceWithConfigure      = &incidentImpactSourceResource{}
+	_ resource.ResourceWithImportState    = &incidentImpactSourceResource{}
+	_ resource.ResourceWithValidateConfig = &incidentImpactSourceResource{}
 )
 
 type incidentImpactResourceModel struct {
@@ -34,8 +39,8 @@ type incidentImpactResourceModel struct {
 	ProjectSlug     types.String `tfsdk:"project_slug"`
 	EnvironmentName types.String `tfsdk:"environment_name"`
 
-	Name         types.String `tfsdk:"name"`
-	ProviderName types.String `tfsdk:"provider_name"`
+	Name         types.String               `tfsdk:"name"`
+	ProviderName caseInsensitiveStringValue `tfsdk:"provider_name"`
 
 	PagerDutyInput   *pagerDutyInputResourceModel   `tfsdk:"pagerduty_input"`
 	DataDogInput     *dataDogInputResourceModel     `tfsdk:"datadog_input"`
@@ -49,15 +54,15 @@ type incidentImpactResourceModel struct {
 }
 
 type pagerDutyInputResourceModel struct {
-	RemoteServices  types.String `tfsdk:"remote_services"`
-	RemoteUrgency   types.String `tfsdk:"remote_urgency"`
-	IntegrationSlug types.String `tfsdk:"integration_slug"`
+	RemoteServices  types.String               `tfsdk:"remote_services"`
+	RemoteUrgency   caseInsensitiveStringValue `tfsdk:"remote_urgency"`
+	IntegrationSlug types.String               `tfsdk:"integration_slug"`
 }
 
 type dataDogInputResourceModel struct {
-	Query                   types.String `tfsdk:"query"`
-	RemotePriorityThreshold types.String `tfsdk:"remote_priority_threshold"`
-	IntegrationSlug         types.String `tfsdk:"integration_slug"`
+	Query                   types.String               `tfsdk:"query"`
+	RemotePriorityThreshold caseInsensitiveStringValue `tfsdk:"remote_priority_threshold"`
+	IntegrationSlug         types.String               `tfsdk:"integration_slug"`
 }
 
 type jiraInputResourceModel struct {
@@ -80,12 +85,12 @@ type statuspageInputResourceModel struct {
 }
 
 type opsgenieInputResourceModel struct {
-	RemoteAlertTags         types.String `tfsdk:"remote_alert_tags"`
-	RemoteIncidentTags      types.String `tfsdk:"remote_incident_tags"`
-	RemotePriorityThreshold types.String `tfsdk:"remote_priority_threshold"`
-	RemoteService           types.String `tfsdk:"remote_service"`
-	RemoteUseAlerts         types.Bool   `tfsdk:"remote_use_alerts"`
-	IntegrationSlug         types.String `tfsdk:"integration_slug"`
+	RemoteAlertTags         types.String               `tfsdk:"remote_alert_tags"`
+	RemoteIncidentTags      types.String               `tfsdk:"remote_incident_tags"`
+	RemotePriorityThreshold caseInsensitiveStringValue `tfsdk:"remote_priority_threshold"`
+	RemoteService           types.String               `tfsdk:"remote_service"`
+	RemoteUseAlerts         types.Bool                 `tfsdk:"remote_use_alerts"`
+	IntegrationSlug         types.String               `tfsdk:"integration_slug"`
 }
 
 type firehydrantInputResourceModel struct {
@@ -108,6 +113,11 @@ type rootlyInputResourceModel struct {
 	IntegrationSlug    types.String `tfsdk:"integration_slug"`
 }
 
+var (
+	incidentProviders  = []string{"pagerduty", "datadog", "jira", "blameless", "statuspage", "opsgenie", "firehydrant", "clubhouse", "rootly"}
+	priorityThresholds = []string{"ALL", "P1", "P2", "P3", "P4", "P5"}
+)
+
 type incidentImpactSourceResource struct {
 	c *gqlclient.Client
 }
@@ -118,7 +128,7 @@ func NewIncidentImpactSourceResource() resource.Resource {
 
 func (iisr *incidentImpactSourceResource) Schema(_ context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
 	res.Schema = schema.Schema{
-		MarkdownDescription: "Sleuth incident impact source.",
+		MarkdownDescription: "Sleuth incident impact source. Each provider also has a resource of its own, such as `sleuth_pagerduty_impact_source`, which sources of the provider can be moved to with a `moved` block.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -144,8 +154,12 @@ func (iisr *incidentImpactSourceResource) Schema(_ context.Context, _ resource.S
 				Required:            true,
 			},
 			"provider_name": schema.StringAttribute{
-				MarkdownDescription: "Impact source provider in lowercase (options: pagerduty, datadog, jira, blameless, statuspage, opsgenie, firehydrant, clubhouse, rootly)",
+				MarkdownDescription: "Impact source provider, in any case (options: pagerduty, datadog, jira, blameless, statuspage, opsgenie, firehydrant, clubhouse, rootly)",
+				CustomType:          caseInsensitiveStringType{},
 				Required:            true,
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive(incidentProviders...),
+				},
 			},
 			"environment_name": schema.StringAttribute{
 				MarkdownDescription: "Impact source environment name",
@@ -163,8 +177,12 @@ func (iisr *incidentImpactSourceResource) Schema(_ context.Context, _ resource.S
 					},
 					"remote_urgency": schema.StringAttribute{
 						MarkdownDescription: "PagerDuty remote urgency, options: HIGH, LOW, ANY",
+						CustomType:          caseInsensitiveStringType{},
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.String{
+							validators.OneOfCaseInsensitive("HIGH", "LOW", "ANY"),
+						},
 					},
 					"integration_slug": schema.StringAttribute{
 						MarkdownDescription: "IntegrationAuthentication slug used",
@@ -184,9 +202,13 @@ See [DataDog documentation](https://docs.datadoghq.com/monitors/manage/search/)
 						Default:  stringdefault.StaticString(""),
 					},
 					"remote_priority_threshold": schema.StringAttribute{
-						Optional: true,
-						Computed: true,
-						Default:  stringdefault.StaticString("ALL"),
+						CustomType: caseInsensitiveStringType{},
+						Optional:   true,
+						Computed:   true,
+						Default:    stringdefault.StaticString("ALL"),
+						Validators: []validator.String{
+							validators.OneOfCaseInsensitive(priorityThresholds...),
+						},
 						Description: `Monitor states with matching or higher priorities will be considered a failure in Sleuth.
 Options: ALL, P1, P2, P3, P4, P5. Defaults to ALL`,
 					},
@@ -268,10 +290,14 @@ Options: ALL, P1, P2, P3, P4, P5. Defaults to ALL`,
 						MarkdownDescription: "Optionally filter by incident tags",
 					},
 					"remote_priority_threshold": schema.StringAttribute{
+						CustomType:          caseInsensitiveStringType{},
 						Optional:            true,
 						Computed:            true,
-						MarkdownDescription: "Monitor states with matching or higher priorities will be considered a failure in Sleuth",
+						MarkdownDescription: "Monitor states with matching or higher priorities will be considered a failure in Sleuth. Options: ALL, P1, P2, P3, P4, P5. Defaults to ALL",
 						Default:             stringdefault.StaticString("ALL"),
+						Validators: []validator.String{
+							validators.OneOfCaseInsensitive(priorityThresholds...),
+						},
 					},
 					"remote_service": schema.StringAttribute{
 						Optional:            true,
@@ -380,6 +406,51 @@ type providerData struct {
 	rootly      *rootlyInputResourceModel
 }
 
+// ValidateConfig validates that the input block of `provider_name`, and only that one, is set
+func (iisr *incidentImpactSourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var providerName caseInsensitiveStringValue
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("provider_name"), &providerName)...)
+	configured := map[string]bool{}
+	for _, provider := range incidentProviders {
+		var input types.Object
+		res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(provider+"_input"), &input)...)
+		if input.IsUnknown() {
+			// the block may turn out to be null
+			return
+		}
+		configured[provider] = !input.IsNull()
+	}
+	if res.Diagnostics.HasError() || providerName.IsUnknown() {
+		return
+	}
+
+	provider := strings.ToLower(providerName.ValueString())
+	if !slices.Contains(incidentProviders, provider) {
+		// reported by the validator of `provider_name`
+		return
+	}
+	for _, p := range incidentProviders {
+		attribute := p + "_input"
+		if p == provider {
+			if !configured[p] {
+				res.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Missing provider input",
+					fmt.Sprintf("%s is required for %s incident impact sources", attribute, provider),
+				)
+			}
+			continue
+		}
+		if configured[p] {
+			res.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Conflicting provider input",
+				fmt.Sprintf("%s cannot be set for %s incident impact sources", attribute, provider),
+			)
+		}
+	}
+}
+
 // we have to manually parse the provider data because the TF protocol v5 doesn't support nested objects
 func parseProviderData(ctx context.Context, plan incidentImpactResourceModel) providerData {
 	return providerData{
@@ -423,14 +494,11 @@ func (iisr *incidentImpactSourceResource) Create(ctx context.Context, req resour
 	tflog.Info(ctx, fmt.Sprintf("Created IncidentImpactSource %+v", iis), map[string]any{"iis": iis, "err": err})
 	if err != nil {
 		tflog.Error(ctx, "Error creating IncidentImpactSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error creating IncidentImpactSource",
-			fmt.Sprintf("Could not create code change soure, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating IncidentImpactSource", fmt.Sprintf("Could not create code change soure, unexpected error: %+v", err.Error()), err, incidentImpactSourceFieldAliases)
 		return
 	}
 
-	state, diags := getNewStateFromIncidentImpactSource(ctx, iis, projectSlug, plan.ProviderName.ValueString(), pd)
+	state, diags := getNewStateFromIncidentImpactSource(ctx, iis, projectSlug)
 	res.Diagnostics.Append(diags...)
 	diags = res.State.Set(ctx, state)
 	res.Diagnostics.Append(diags...)
@@ -445,19 +513,16 @@ func (iisr *incidentImpactSourceResource) Read(ctx context.Context, req resource
 	diags := req.State.Get(ctx, &state)
 	res.Diagnostics.Append(diags...)
 
-	pd := parseProviderData(ctx, state)
-
 	tflog.Info(ctx, "Reading IncidentImpactSource resource", map[string]any{"state": state})
 	projectSlug := state.ProjectSlug.ValueString()
 	slug := state.Slug.ValueString()
-	if state.ProjectSlug.ValueString() == "" {
-		id := state.ID.ValueString()
-		splits := strings.Split(id, "/")
-		projectSlug = splits[0]
-		slug = splits[1]
-	}
 
 	ccs, err := iisr.c.GetIncidentImpactSource(ctx, projectSlug, slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "IncidentImpactSource not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		tflog.Error(ctx, "Error reading IncidentImpactSource", map[string]any{"error": err.Error()})
 		res.Diagnostics.AddError(
@@ -466,7 +531,7 @@ func (iisr *incidentImpactSourceResource) Read(ctx context.Context, req resource
 		)
 		return
 	}
-	newState, diags := getNewStateFromIncidentImpactSource(ctx, ccs, projectSlug, state.ProviderName.ValueString(), pd)
+	newState, diags := getNewStateFromIncidentImpactSource(ctx, ccs, projectSlug)
 	res.Diagnostics.Append(diags...)
 
 	diags = res.State.Set(ctx, newState)
@@ -505,14 +570,11 @@ func (iisr *incidentImpactSourceResource) Update(ctx context.Context, req resour
 	ccs, err := iisr.c.UpdateIncidentImpactSource(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error updating IncidentImpactSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error updating IncidentImpactSource",
-			fmt.Sprintf("Could not update code change soure, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating IncidentImpactSource", fmt.Sprintf("Could not update code change soure, unexpected error: %+v", err.Error()), err, incidentImpactSourceFieldAliases)
 		return
 	}
 
-	newState, diags := getNewStateFromIncidentImpactSource(ctx, ccs, projectSlug, plan.ProviderName.ValueString(), pd)
+	newState, diags := getNewStateFromIncidentImpactSource(ctx, ccs, projectSlug)
 	res.Diagnostics.Append(diags...)
 
 	diags = res.State.Set(ctx, newState)
@@ -545,17 +607,26 @@ func (iisr *incidentImpactSourceResource) Delete(ctx context.Context, req resour
 }
 
 func (iisr *incidentImpactSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	// incident impact sources are imported by `project_slug/slug` or `project_slug/name:<Name>`
+	importProjectScopedResource(ctx, req, res, "IncidentImpactSource", func(ctx context.Context, projectSlug, name string) (string, error) {
+		iis, err := iisr.c.GetIncidentImpactSourceByName(ctx, projectSlug, name)
+		if err != nil {
+			return "", err
+		}
+		return iis.Slug, nil
+	})
 }
 
-func getNewStateFromIncidentImpactSource(ctx context.Context, iis *gqlclient.IncidentImpactSource, projectSlug string, originalProviderName string, data providerData) (incidentImpactResourceModel, diag.Diagnostics) {
+// getNewStateFromIncidentImpactSource builds the state from the API alone, so an imported source gets the provider block
+// matching its provider.
+func getNewStateFromIncidentImpactSource(ctx context.Context, iis *gqlclient.IncidentImpactSource, projectSlug string) (incidentImpactResourceModel, diag.Diagnostics) {
 	iirm := incidentImpactResourceModel{
 		ID:               types.StringValue(iis.Slug),
 		Slug:             types.StringValue(iis.Slug),
 		ProjectSlug:      types.StringValue(projectSlug),
 		EnvironmentName:  types.StringValue(iis.Environment.Name),
 		Name:             types.StringValue(iis.Name),
-		ProviderName:     types.StringValue(originalProviderName),
+		ProviderName:     newCaseInsensitiveStringValue(iis.Provider),
 		PagerDutyInput:   nil,
 		DataDogInput:     nil,
 		JiraInput:        nil,
@@ -567,133 +638,136 @@ func getNewStateFromIncidentImpactSource(ctx context.Context, iis *gqlclient.Inc
 		RootlyInput:      nil,
 	}
 
-	return getProviderSpecificStateValue(ctx, iis, iirm, data)
+	return getProviderSpecificStateValue(ctx, iis, iirm)
 }
 
-func getProviderSpecificStateValue(ctx context.Context, iis *gqlclient.IncidentImpactSource, stateObj incidentImpactResourceModel, data providerData) (incidentImpactResourceModel, diag.Diagnostics) {
-	diags := diag.Diagnostics{}
-	pd := &pagerDutyInputResourceModel{
-		RemoteUrgency:   types.StringValue(iis.ProviderData.PagerDutyProviderData.RemoteUrgency),
-		RemoteServices:  types.StringValue(iis.ProviderData.PagerDutyProviderData.RemoteServices),
-		IntegrationSlug: types.StringNull(),
+// getProviderSpecificStateValue sets the block of the provider returned by the API, the provider data of the others is
+// empty
+func getProviderSpecificStateValue(ctx context.Context, iis *gqlclient.IncidentImpactSource, stateObj incidentImpactResourceModel) (incidentImpactResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch strings.ToLower(iis.Provider) {
+	case "pagerduty":
+		pd := pagerDutyInputState(iis)
+		stateObj.PagerDutyInput = &pd
+	case "datadog":
+		dd := dataDogInputState(iis)
+		stateObj.DataDogInput = &dd
+	case "jira":
+		jira := jiraInputState(iis)
+		stateObj.JiraInput = &jira
+	case "blameless":
+		var blameless blamelessInputResourceModel
+		blameless, diags = blamelessInputState(iis)
+		stateObj.BlamelessInput = &blameless
+	case "statuspage":
+		statuspage := statuspageInputState(iis)
+		stateObj.StatusPageInput = &statuspage
+	case "opsgenie":
+		opsgenie := opsgenieInputState(iis)
+		stateObj.OpsGenieInput = &opsgenie
+	case "firehydrant":
+		firehydrant := firehydrantInputState(iis)
+		stateObj.FireHydrantInput = &firehydrant
+	case "clubhouse":
+		clubhouse := clubhouseInputState(iis)
+		stateObj.ClubhouseInput = &clubhouse
+	case "rootly":
+		rootly := rootlyInputState(iis)
+		stateObj.RootlyInput = &rootly
 	}
 
-	if iis.IntegrationAuthSlug != "" {
-		pd.IntegrationSlug = types.StringValue(iis.IntegrationAuthSlug)
-	}
+	return stateObj, diags
+}
 
-	dd := &dataDogInputResourceModel{
-		Query:                   types.StringValue(iis.ProviderData.DataDogProviderData.Query),
-		RemotePriorityThreshold: types.StringValue(iis.ProviderData.DataDogProviderData.RemotePriorityThreshold),
-		IntegrationSlug:         types.StringNull(),
+// integrationSlugState returns the integration slug of iis, null when it has none
+func integrationSlugState(iis *gqlclient.IncidentImpactSource) types.String {
+	if iis.IntegrationAuthSlug == "" {
+		return types.StringNull()
 	}
+	return types.StringValue(iis.IntegrationAuthSlug)
+}
 
-	if iis.IntegrationAuthSlug != "" {
-		dd.IntegrationSlug = types.StringValue(iis.IntegrationAuthSlug)
+func pagerDutyInputState(iis *gqlclient.IncidentImpactSource) pagerDutyInputResourceModel {
+	return pagerDutyInputResourceModel{
+		RemoteUrgency:   newCaseInsensitiveStringValue(iis.ProviderData.PagerDutyProviderData.RemoteUrgency),
+		RemoteServices:  types.StringValue(iis.ProviderData.PagerDutyProviderData.RemoteServices),
+		IntegrationSlug: integrationSlugState(iis),
+	}
+}
 
+func dataDogInputState(iis *gqlclient.IncidentImpactSource) dataDogInputResourceModel {
+	return dataDogInputResourceModel{
+		Query:                   types.StringValue(iis.ProviderData.DataDogProviderData.Query),
+		RemotePriorityThreshold: newCaseInsensitiveStringValue(iis.ProviderData.DataDogProviderData.RemotePriorityThreshold),
+		IntegrationSlug:         integrationSlugState(iis),
 	}
+}
 
-	jira := &jiraInputResourceModel{
+func jiraInputState(iis *gqlclient.IncidentImpactSource) jiraInputResourceModel {
+	return jiraInputResourceModel{
 		RemoteJQL:       types.StringValue(iis.ProviderData.JiraProviderData.RemoteJql),
-		IntegrationSlug: types.StringNull(),
-	}
-	if iis.IntegrationAuthSlug != "" {
-		jira.IntegrationSlug = types.StringValue(iis.IntegrationAuthSlug)
+		IntegrationSlug: integrationSlugState(iis),
 	}
+}
 
+func blamelessInputState(iis *gqlclient.IncidentImpactSource) (blamelessInputResourceModel, diag.Diagnostics) {
 	var t []attr.Value
 	for _, remoteType := range iis.ProviderData.BlamelessProviderData.RemoteTypes {
-		typ := types.StringValue(remoteType)
-		t = append(t, typ)
+		t = append(t, types.StringValue(remoteType))
 	}
-	sv, errDiag := types.SetValue(types.StringType, t)
+	sv, diags := types.SetValue(types.StringType, t)
 
-	diags.Append(errDiag...)
-
-	blameless := &blamelessInputResourceModel{
+	return blamelessInputResourceModel{
 		RemoteSeverityThreshold: types.StringValue(iis.ProviderData.BlamelessProviderData.RemoteSeverityThreshold),
 		RemoteTypes:             sv,
-	}
+	}, diags
+}
 
-	statuspage := &statuspageInputResourceModel{
+func statuspageInputState(iis *gqlclient.IncidentImpactSource) statuspageInputResourceModel {
+	return statuspageInputResourceModel{
 		RemotePage:                 types.StringValue(iis.ProviderData.StatuspageProviderData.RemotePage),
 		RemoteComponent:            types.StringValue(iis.ProviderData.StatuspageProviderData.RemoteComponent),
 		RemoteImpact:               types.StringValue(iis.ProviderData.StatuspageProviderData.RemoteImpact),
 		IgnoreMaintenanceIncidents: types.BoolValue(iis.ProviderData.StatuspageProviderData.IgnoreMaintenanceIncidents),
-		IntegrationSlug:            types.StringNull(),
-	}
-	if iis.IntegrationAuthSlug != "" {
-		statuspage.IntegrationSlug = types.StringValue(iis.IntegrationAuthSlug)
+		IntegrationSlug:            integrationSlugState(iis),
 	}
+}
 
-	opsgenie := &opsgenieInputResourceModel{
+func opsgenieInputState(iis *gqlclient.IncidentImpactSource) opsgenieInputResourceModel {
+	return opsgenieInputResourceModel{
 		RemoteAlertTags:         types.StringValue(iis.ProviderData.OpsGenieProviderData.RemoteAlertTags),
 		RemoteIncidentTags:      types.StringValue(iis.ProviderData.OpsGenieProviderData.RemoteIncidentTags),
-		RemotePriorityThreshold: types.StringValue(iis.ProviderData.OpsGenieProviderData.RemotePriorityThreshold),
+		RemotePriorityThreshold: newCaseInsensitiveStringValue(iis.ProviderData.OpsGenieProviderData.RemotePriorityThreshold),
 		RemoteService:           types.StringValue(iis.ProviderData.OpsGenieProviderData.RemoteService),
 		RemoteUseAlerts:         types.BoolValue(iis.ProviderData.OpsGenieProviderData.RemoteUseAlerts),
-		IntegrationSlug:         types.StringNull(),
-	}
-	if iis.IntegrationAuthSlug != "" {
-		opsgenie.IntegrationSlug = types.StringValue(iis.IntegrationAuthSlug)
+		IntegrationSlug:         integrationSlugState(iis),
 	}
+}
 
-	firehydrant := &firehydrantInputResourceModel{
+func firehydrantInputState(iis *gqlclient.IncidentImpactSource) firehydrantInputResourceModel {
+	return firehydrantInputResourceModel{
 		RemoteEnvironments:       types.StringValue(iis.ProviderData.FireHydrantProviderData.RemoteEnvironments),
 		RemoteServices:           types.StringValue(iis.ProviderData.FireHydrantProviderData.RemoteServices),
 		RemoteMitigatedIsHealthy: types.BoolValue(iis.ProviderData.FireHydrantProviderData.RemoteMitigatedIsHealthy),
 	}
+}
 
-	clubhouse := &clubhouseInputResourceModel{
+func clubhouseInputState(iis *gqlclient.IncidentImpactSource) clubhouseInputResourceModel {
+	return clubhouseInputResourceModel{
 		RemoteQuery:     types.StringValue(iis.ProviderData.ClubhouseProviderData.RemoteQuery),
-		IntegrationSlug: types.StringNull(),
-	}
-	if iis.IntegrationAuthSlug != "" {
-		clubhouse.IntegrationSlug = types.StringValue(iis.IntegrationAuthSlug)
+		IntegrationSlug: integrationSlugState(iis),
 	}
+}
 
-	rootly := &rootlyInputResourceModel{
+func rootlyInputState(iis *gqlclient.IncidentImpactSource) rootlyInputResourceModel {
+	return rootlyInputResourceModel{
 		RemoteSeverity:     types.StringValue(iis.ProviderData.RootlyProviderData.RemoteSeverity),
 		RemoteIncidentType: types.StringValue(iis.ProviderData.RootlyProviderData.RemoteIncidentType),
 		RemoteEnvironment:  types.StringValue(iis.ProviderData.RootlyProviderData.RemoteEnvironment),
 		RemoteService:      types.StringValue(iis.ProviderData.RootlyProviderData.RemoteService),
 		RemoteTeam:         types.StringValue(iis.ProviderData.RootlyProviderData.RemoteTeam),
-		IntegrationSlug:    types.StringNull(),
-	}
-	if iis.IntegrationAuthSlug != "" {
-		rootly.IntegrationSlug = types.StringValue(iis.IntegrationAuthSlug)
-	}
-
-	if data.pagerduty != nil {
-		stateObj.PagerDutyInput = pd
+		IntegrationSlug:    integrationSlugState(iis),
 	}
-	if data.datadog != nil {
-		stateObj.DataDogInput = dd
-	}
-	if data.jira != nil {
-		stateObj.JiraInput = jira
-	}
-	if data.blameless != nil {
-		stateObj.BlamelessInput = blameless
-	}
-	if data.statuspage != nil {
-		stateObj.StatusPageInput = statuspage
-	}
-	if data.opsgenie != nil {
-		stateObj.OpsGenieInput = opsgenie
-	}
-	if data.firehydrant != nil {
-		stateObj.FireHydrantInput = firehydrant
-	}
-	if data.clubhouse != nil {
-		stateObj.ClubhouseInput = clubhouse
-	}
-	if data.rootly != nil {
-		stateObj.RootlyInput = rootly
-	}
-
-	return stateObj, diags
-
 }
 
 func getMutableIncidentImpactSourceStruct(ctx context.Context, plan incidentImpactResourceModel, data providerData) (gqlclient.IncidentImpactSourceInputType, diag.Diagnostics) {
@@ -701,7 +775,7 @@ func getMutableIncidentImpactSourceStruct(ctx context.Context, plan incidentImpa
 		ProjectSlug:          plan.ProjectSlug.ValueString(),
 		EnvironmentName:      strings.ToLower(plan.EnvironmentName.ValueString()),
 		Name:                 plan.Name.ValueString(),
-		Provider:             strings.ToUpper(plan.ProviderName.ValueString()),
+		Provider:             plan.ProviderName.upper(),
 		PagerDutyInputType:   nil,
 		DataDogInputType:     nil,
 		JiraInputType:        nil,
@@ -719,7 +793,7 @@ func getProviderSpecificData(ctx context.Context, input gqlclient.IncidentImpact
 	if data.pagerduty != nil {
 		input.PagerDutyInputType = &gqlclient.PagerDutyInputType{
 			RemoteServices: data.pagerduty.RemoteServices.ValueString(),
-			RemoteUrgency:  data.pagerduty.RemoteUrgency.ValueString(),
+			RemoteUrgency:  data.pagerduty.RemoteUrgency.upper(),
 		}
 	}
 
@@ -727,7 +801,7 @@ func getProviderSpecificData(ctx context.Context, input gqlclient.IncidentImpact
 		input.DataDogInputType = &gqlclient.DataDogInputType{
 			DataDogProviderData: gqlclient.DataDogProviderData{
 				Query:                   data.datadog.Query.ValueString(),
-				RemotePriorityThreshold: data.datadog.RemotePriorityThreshold.ValueString(),
+				RemotePriorityThreshold: data.datadog.RemotePriorityThreshold.upper(),
 			},
 			IntegrationSlug: data.datadog.IntegrationSlug.ValueString(),
 		}
@@ -775,7 +849,7 @@ func getProviderSpecificData(ctx context.Context, input gqlclient.IncidentImpact
 			OpsGenieProviderData: gqlclient.OpsGenieProviderData{
 				RemoteAlertTags:         data.opsgenie.RemoteAlertTags.ValueString(),
 				RemoteIncidentTags:      data.opsgenie.RemoteIncidentTags.ValueString(),
-				RemotePriorityThreshold: data.opsgenie.RemotePriorityThreshold.ValueString(),
+				RemotePriorityThreshold: data.opsgenie.RemotePriorityThreshold.upper(),
 				RemoteService:           data.opsgenie.RemoteService.ValueString(),
 				RemoteUseAlerts:         data.opsgenie.RemoteUseAlerts.ValueBool(),
 			},
@@ -2,6 +2,7 @@ package sleuth
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -32,6 +33,24 @@ func TestAccIncidentImpactSourceResource_v6(t *testing.T) {
 			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version1_3_0),
 		},
 		Steps: []resource.TestStep{
+			// the input block must match the provider
+			{
+				Config: fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_incident_impact_source" "terraform_acc_test_pd" {
+	project_slug     = sleuth_project.terraform_acc_test.slug
+	environment_name = "Production"
+	name             = "PagerDuty TF incident impact"
+	provider_name    = "PagerDuty"
+	datadog_input = {
+		query = "@query=123"
+	}
+}`, projectString),
+				ExpectError: regexp.MustCompile(`(?s)pagerduty_input is required for pagerduty incident impact sources.*datadog_input cannot be set for\s+pagerduty incident impact sources`),
+			},
 			// Create and Read testing
 			{
 				Config: createIncidentImpactConfig(projectString),
@@ -117,6 +136,19 @@ func TestAccIncidentImpactSourceResource_v6(t *testing.T) {
 					resource.TestCheckResourceAttr("sleuth_incident_impact_source.terraform_acc_test_jira", "jira_input.remote_jql", "created >= -10d order by created DESC"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "sleuth_incident_impact_source.terraform_acc_test_dd",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_incident_impact_source.terraform_acc_test_dd"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sleuth_incident_impact_source.terraform_acc_test_jira",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDByName("sleuth_incident_impact_source.terraform_acc_test_jira"),
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,533 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ resource.Resource                = &incidentProviderImpactSourceResource[pagerDutyImpactSourceResourceModel]{}
+	_ resource.ResourceWithConfigure   = &incidentProviderImpactSourceResource[pagerDutyImpactSourceResourceModel]{}
+	_ resource.ResourceWithImportState = &incidentProviderImpactSourceResource[pagerDutyImpactSourceResourceModel]{}
+	_ resource.ResourceWithMoveState   = &incidentProviderImpactSourceResource[pagerDutyImpactSourceResourceModel]{}
+)
+
+// providerImpactSourceResourceModel holds the attributes the incident impact source resources of all providers have
+type providerImpactSourceResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Slug types.String `tfsdk:"slug"`
+
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	EnvironmentName types.String `tfsdk:"environment_name"`
+
+	Name types.String `tfsdk:"name"`
+}
+
+func newProviderImpactSourceResourceModel(iirm incidentImpactResourceModel) providerImpactSourceResourceModel {
+	return providerImpactSourceResourceModel{
+		ID:              iirm.ID,
+		Slug:            iirm.Slug,
+		ProjectSlug:     iirm.ProjectSlug,
+		EnvironmentName: iirm.EnvironmentName,
+		Name:            iirm.Name,
+	}
+}
+
+// incidentImpactSource returns the sleuth_incident_impact_source model without provider, and without input block
+func (m providerImpactSourceResourceModel) incidentImpactSource() incidentImpactResourceModel {
+	return incidentImpactResourceModel{
+		ID:              m.ID,
+		Slug:            m.Slug,
+		ProjectSlug:     m.ProjectSlug,
+		EnvironmentName: m.EnvironmentName,
+		Name:            m.Name,
+	}
+}
+
+type pagerDutyImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	pagerDutyInputResourceModel
+}
+
+type dataDogIncidentImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	dataDogInputResourceModel
+}
+
+type jiraImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	jiraInputResourceModel
+}
+
+type blamelessImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	blamelessInputResourceModel
+}
+
+type statuspageImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	statuspageInputResourceModel
+}
+
+type opsgenieImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	opsgenieInputResourceModel
+}
+
+type firehydrantImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	firehydrantInputResourceModel
+}
+
+type clubhouseImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	clubhouseInputResourceModel
+}
+
+type rootlyImpactSourceResourceModel struct {
+	providerImpactSourceResourceModel
+	rootlyInputResourceModel
+}
+
+// incidentProviderImpactSourceResource is the incident impact source resource of a single provider, such as
+// sleuth_pagerduty_impact_source. The attributes of the provider's input block of sleuth_incident_impact_source are
+// top level attributes, so M embeds providerImpactSourceResourceModel and the input model of the provider.
+type incidentProviderImpactSourceResource[M any] struct {
+	c *gqlclient.Client
+
+	// provider is the lowercase provider_name of sleuth_incident_impact_source
+	provider string
+	// title names the provider in descriptions
+	title    string
+	typeName string
+
+	// toIncidentImpactSource and fromIncidentImpactSource convert between M and the sleuth_incident_impact_source model
+	// with the input block of the provider, so the resources share its conversions to and from the API
+	toIncidentImpactSource   func(M) incidentImpactResourceModel
+	fromIncidentImpactSource func(incidentImpactResourceModel) M
+}
+
+func NewPagerDutyImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[pagerDutyImpactSourceResourceModel]{
+		provider: "pagerduty",
+		title:    "PagerDuty",
+		typeName: "pagerduty_impact_source",
+		toIncidentImpactSource: func(m pagerDutyImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.PagerDutyInput = &m.pagerDutyInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) pagerDutyImpactSourceResourceModel {
+			return pagerDutyImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.PagerDutyInput}
+		},
+	}
+}
+
+// NewDataDogIncidentImpactSourceResource returns sleuth_datadog_incident_impact_source, named apart from the DataDog
+// metric impact sources
+func NewDataDogIncidentImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[dataDogIncidentImpactSourceResourceModel]{
+		provider: "datadog",
+		title:    "DataDog",
+		typeName: "datadog_incident_impact_source",
+		toIncidentImpactSource: func(m dataDogIncidentImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.DataDogInput = &m.dataDogInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) dataDogIncidentImpactSourceResourceModel {
+			return dataDogIncidentImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.DataDogInput}
+		},
+	}
+}
+
+func NewJiraImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[jiraImpactSourceResourceModel]{
+		provider: "jira",
+		title:    "JIRA",
+		typeName: "jira_impact_source",
+		toIncidentImpactSource: func(m jiraImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.JiraInput = &m.jiraInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) jiraImpactSourceResourceModel {
+			return jiraImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.JiraInput}
+		},
+	}
+}
+
+func NewBlamelessImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[blamelessImpactSourceResourceModel]{
+		provider: "blameless",
+		title:    "Blameless",
+		typeName: "blameless_impact_source",
+		toIncidentImpactSource: func(m blamelessImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.BlamelessInput = &m.blamelessInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) blamelessImpactSourceResourceModel {
+			return blamelessImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.BlamelessInput}
+		},
+	}
+}
+
+func NewStatuspageImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[statuspageImpactSourceResourceModel]{
+		provider: "statuspage",
+		title:    "Statuspage",
+		typeName: "statuspage_impact_source",
+		toIncidentImpactSource: func(m statuspageImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.StatusPageInput = &m.statuspageInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) statuspageImpactSourceResourceModel {
+			return statuspageImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.StatusPageInput}
+		},
+	}
+}
+
+func NewOpsGenieImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[opsgenieImpactSourceResourceModel]{
+		provider: "opsgenie",
+		title:    "OpsGenie",
+		typeName: "opsgenie_impact_source",
+		toIncidentImpactSource: func(m opsgenieImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.OpsGenieInput = &m.opsgenieInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) opsgenieImpactSourceResourceModel {
+			return opsgenieImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.OpsGenieInput}
+		},
+	}
+}
+
+func NewFireHydrantImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[firehydrantImpactSourceResourceModel]{
+		provider: "firehydrant",
+		title:    "FireHydrant",
+		typeName: "firehydrant_impact_source",
+		toIncidentImpactSource: func(m firehydrantImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.FireHydrantInput = &m.firehydrantInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) firehydrantImpactSourceResourceModel {
+			return firehydrantImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.FireHydrantInput}
+		},
+	}
+}
+
+func NewClubhouseImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[clubhouseImpactSourceResourceModel]{
+		provider: "clubhouse",
+		title:    "Clubhouse",
+		typeName: "clubhouse_impact_source",
+		toIncidentImpactSource: func(m clubhouseImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.ClubhouseInput = &m.clubhouseInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) clubhouseImpactSourceResourceModel {
+			return clubhouseImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.ClubhouseInput}
+		},
+	}
+}
+
+func NewRootlyImpactSourceResource() resource.Resource {
+	return &incidentProviderImpactSourceResource[rootlyImpactSourceResourceModel]{
+		provider: "rootly",
+		title:    "Rootly",
+		typeName: "rootly_impact_source",
+		toIncidentImpactSource: func(m rootlyImpactSourceResourceModel) incidentImpactResourceModel {
+			iirm := m.incidentImpactSource()
+			iirm.RootlyInput = &m.rootlyInputResourceModel
+			return iirm
+		},
+		fromIncidentImpactSource: func(iirm incidentImpactResourceModel) rootlyImpactSourceResourceModel {
+			return rootlyImpactSourceResourceModel{newProviderImpactSourceResourceModel(iirm), *iirm.RootlyInput}
+		},
+	}
+}
+
+// incidentProviderFieldAliases maps API input fields to the attributes of the resource of provider, where the fields of
+// the provider input are top level attributes
+func incidentProviderFieldAliases(provider string) fieldAliases {
+	aliases := fieldAliases{
+		"provider":          "",
+		provider + "_input": "",
+	}
+	if provider == "pagerduty" {
+		aliases["pager_duty_input"] = ""
+	}
+	return aliases
+}
+
+// incidentImpactSourceSchema returns the schema of sleuth_incident_impact_source, which the resources of the providers
+// take their attributes from
+func incidentImpactSourceSchema(ctx context.Context) schema.Schema {
+	var res resource.SchemaResponse
+	(&incidentImpactSourceResource{}).Schema(ctx, resource.SchemaRequest{}, &res)
+	return res.Schema
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) Schema(ctx context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+	generic := incidentImpactSourceSchema(ctx)
+	attributes := map[string]schema.Attribute{}
+	for _, name := range []string{"id", "slug", "project_slug", "name", "environment_name"} {
+		attributes[name] = generic.Attributes[name]
+	}
+	maps.Copy(attributes, generic.Attributes[ipr.provider+"_input"].(schema.SingleNestedAttribute).Attributes)
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(`Sleuth %s incident impact source.
+
+A `+"`sleuth_incident_impact_source`"+` with `+"`provider_name = \"%s\"`"+` can be moved to this resource with a `+"`moved`"+` block (Terraform 1.8 or later) without being recreated.`, ipr.title, ipr.provider),
+		Attributes: attributes,
+	}
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	ipr.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_" + ipr.typeName
+}
+
+// incidentImpactSource returns the sleuth_incident_impact_source model of m
+func (ipr *incidentProviderImpactSourceResource[M]) incidentImpactSource(m M) incidentImpactResourceModel {
+	iirm := ipr.toIncidentImpactSource(m)
+	iirm.ProviderName = newCaseInsensitiveStringValue(ipr.provider)
+	return iirm
+}
+
+// state builds the state of iis, which must still use the provider of the resource
+func (ipr *incidentProviderImpactSourceResource[M]) state(ctx context.Context, iis *gqlclient.IncidentImpactSource, projectSlug string) (M, diag.Diagnostics) {
+	var state M
+	var diags diag.Diagnostics
+	if provider := strings.ToLower(iis.Provider); provider != ipr.provider {
+		diags.AddError(
+			"Unexpected incident impact source provider",
+			fmt.Sprintf("Incident impact source %s uses %s instead of %s, it can be managed with sleuth_incident_impact_source", iis.Slug, provider, ipr.provider),
+		)
+		return state, diags
+	}
+
+	iirm, diags := getNewStateFromIncidentImpactSource(ctx, iis, projectSlug)
+	return ipr.fromIncidentImpactSource(iirm), diags
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "resource", ipr.typeName)
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan M
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		tflog.Error(ctx, "Error getting IncidentImpactSource plan", map[string]any{"diagnostics": res.Diagnostics})
+		return
+	}
+
+	iirm := ipr.incidentImpactSource(plan)
+	input, diags := getMutableIncidentImpactSourceStruct(ctx, iirm, parseProviderData(ctx, iirm))
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating IncidentImpactSource resource", map[string]any{"name": input.Name, "projectSlug": input.ProjectSlug})
+	iis, err := ipr.c.CreateIncidentImpactSource(ctx, input)
+	if err != nil {
+		tflog.Error(ctx, "Error creating IncidentImpactSource", map[string]any{"error": err.Error()})
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating IncidentImpactSource", fmt.Sprintf("Could not create incident impact source, unexpected error: %+v", err.Error()), err, incidentProviderFieldAliases(ipr.provider))
+		return
+	}
+
+	state, diags := ipr.state(ctx, iis, input.ProjectSlug)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "resource", ipr.typeName)
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var current M
+	res.Diagnostics.Append(req.State.Get(ctx, &current)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	iirm := ipr.incidentImpactSource(current)
+	projectSlug := iirm.ProjectSlug.ValueString()
+
+	tflog.Info(ctx, "Reading IncidentImpactSource resource", map[string]any{"projectSlug": projectSlug, "slug": iirm.Slug.ValueString()})
+	iis, err := ipr.c.GetIncidentImpactSource(ctx, projectSlug, iirm.Slug.ValueString())
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "IncidentImpactSource not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error reading IncidentImpactSource", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError(
+			"Error reading IncidentImpactSource",
+			fmt.Sprintf("Could not read incident impact source, unexpected error: %+v", err.Error()),
+		)
+		return
+	}
+
+	state, diags := ipr.state(ctx, iis, projectSlug)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "resource", ipr.typeName)
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var current, plan M
+	res.Diagnostics.Append(req.State.Get(ctx, &current)...)
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		tflog.Error(ctx, "Error getting IncidentImpactSource plan", map[string]any{"diagnostics": res.Diagnostics})
+		return
+	}
+
+	iirm := ipr.incidentImpactSource(plan)
+	inputFields, diags := getMutableIncidentImpactSourceStruct(ctx, iirm, parseProviderData(ctx, iirm))
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	input := gqlclient.IncidentImpactSourceInputUpdateType{
+		Slug:                          ipr.incidentImpactSource(current).Slug.ValueString(),
+		IncidentImpactSourceInputType: inputFields,
+	}
+
+	tflog.Info(ctx, "Updating IncidentImpactSource resource", map[string]any{"slug": input.Slug, "projectSlug": inputFields.ProjectSlug})
+	iis, err := ipr.c.UpdateIncidentImpactSource(ctx, input)
+	if err != nil {
+		tflog.Error(ctx, "Error updating IncidentImpactSource", map[string]any{"error": err.Error()})
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating IncidentImpactSource", fmt.Sprintf("Could not update incident impact source, unexpected error: %+v", err.Error()), err, incidentProviderFieldAliases(ipr.provider))
+		return
+	}
+
+	state, diags := ipr.state(ctx, iis, inputFields.ProjectSlug)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "resource", ipr.typeName)
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var current M
+	res.Diagnostics.Append(req.State.Get(ctx, &current)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	iirm := ipr.incidentImpactSource(current)
+
+	tflog.Info(ctx, "Deleting IncidentImpactSource resource", map[string]any{"projectSlug": iirm.ProjectSlug.ValueString(), "slug": iirm.Slug.ValueString()})
+	err := ipr.c.DeleteImpactSource(ctx, iirm.ProjectSlug.ValueStringPointer(), iirm.Slug.ValueStringPointer())
+	if err != nil {
+		tflog.Error(ctx, "Error deleting IncidentImpactSource", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError(
+			"Error deleting IncidentImpactSource",
+			fmt.Sprintf("Could not delete incident impact source, unexpected error: %+v", err.Error()),
+		)
+		return
+	}
+}
+
+func (ipr *incidentProviderImpactSourceResource[M]) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	// incident impact sources are imported by `project_slug/slug` or `project_slug/name:<Name>`
+	importProjectScopedResource(ctx, req, res, "IncidentImpactSource", func(ctx context.Context, projectSlug, name string) (string, error) {
+		iis, err := ipr.c.GetIncidentImpactSourceByName(ctx, projectSlug, name)
+		if err != nil {
+			return "", err
+		}
+		return iis.Slug, nil
+	})
+}
+
+// MoveState moves the state of a sleuth_incident_impact_source of the provider, whose input block becomes the top
+// level attributes, so a `moved` block does not recreate the impact source
+func (ipr *incidentProviderImpactSourceResource[M]) MoveState(ctx context.Context) []resource.StateMover {
+	sourceSchema := incidentImpactSourceSchema(ctx)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, res *resource.MoveStateResponse) {
+				if req.SourceTypeName != "sleuth_incident_impact_source" || !strings.HasSuffix(req.SourceProviderAddress, "/sleuth") {
+					// not a move this resource supports, the framework reports it when no other mover does
+					return
+				}
+				if req.SourceState == nil {
+					res.Diagnostics.AddError(
+						"Unable to move IncidentImpactSource",
+						"The state of the sleuth_incident_impact_source could not be read, it may have been written by an incompatible version of the provider",
+					)
+					return
+				}
+
+				var source incidentImpactResourceModel
+				res.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if res.Diagnostics.HasError() {
+					return
+				}
+				if provider := strings.ToLower(source.ProviderName.ValueString()); provider != ipr.provider {
+					res.Diagnostics.AddError(
+						"Unable to move IncidentImpactSource",
+						fmt.Sprintf("Only %s incident impact sources can be moved to sleuth_%s, %s uses %s", ipr.provider, ipr.typeName, source.Slug.ValueString(), provider),
+					)
+					return
+				}
+				var input types.Object
+				res.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root(ipr.provider+"_input"), &input)...)
+				if res.Diagnostics.HasError() {
+					return
+				}
+				if input.IsNull() {
+					res.Diagnostics.AddError(
+						"Unable to move IncidentImpactSource",
+						fmt.Sprintf("%s has no %s_input, refresh it with the current provider version before moving it", source.Slug.ValueString(), ipr.provider),
+					)
+					return
+				}
+
+				res.Diagnostics.Append(res.TargetState.Set(ctx, ipr.fromIncidentImpactSource(source))...)
+			},
+		},
+	}
+}
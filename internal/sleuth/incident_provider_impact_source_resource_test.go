@@ -0,0 +1,189 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIncidentProviderImpactSourceResources(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: incidentProviderImpactSourcesConfig(projectString, "ANY", "P2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_pagerduty_impact_source.terraform_acc_test", "name", "PagerDuty incidents"),
+					resource.TestCheckResourceAttr("sleuth_pagerduty_impact_source.terraform_acc_test", "environment_name", "Production"),
+					resource.TestCheckResourceAttr("sleuth_pagerduty_impact_source.terraform_acc_test", "remote_services", "PIMPOA4"),
+					resource.TestCheckResourceAttr("sleuth_pagerduty_impact_source.terraform_acc_test", "remote_urgency", "ANY"),
+					resource.TestCheckResourceAttr("sleuth_datadog_incident_impact_source.terraform_acc_test", "query", "service:payments"),
+					resource.TestCheckResourceAttr("sleuth_datadog_incident_impact_source.terraform_acc_test", "remote_priority_threshold", "P2"),
+					resource.TestCheckResourceAttr("sleuth_rootly_impact_source.terraform_acc_test", "remote_severity", "HIGH"),
+					resource.TestCheckResourceAttr("sleuth_rootly_impact_source.terraform_acc_test", "remote_team", "payments"),
+					resource.TestCheckNoResourceAttr("sleuth_rootly_impact_source.terraform_acc_test", "integration_slug"),
+				),
+			},
+			// Update testing
+			{
+				Config: incidentProviderImpactSourcesConfig(projectString, "HIGH", "ALL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_pagerduty_impact_source.terraform_acc_test", "remote_urgency", "HIGH"),
+					resource.TestCheckResourceAttr("sleuth_datadog_incident_impact_source.terraform_acc_test", "remote_priority_threshold", "ALL"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sleuth_pagerduty_impact_source.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_pagerduty_impact_source.terraform_acc_test"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sleuth_rootly_impact_source.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDByName("sleuth_rootly_impact_source.terraform_acc_test"),
+				ImportStateVerify: true,
+			},
+			// sources of other providers are not managed by the resource of a provider
+			{
+				ResourceName:      "sleuth_rootly_impact_source.terraform_acc_test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_datadog_incident_impact_source.terraform_acc_test"),
+				ExpectError:       regexp.MustCompile(`uses datadog instead of rootly`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIncidentProviderImpactSourceResources_moved(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// moving state between resource types was added in Terraform 1.8
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: incidentImpactSourceToMoveConfig(projectString),
+			},
+			// only sources of the provider can be moved
+			{
+				Config: incidentImpactSourceToMoveConfig(projectString) + `
+moved {
+	from = sleuth_incident_impact_source.terraform_acc_test
+	to   = sleuth_rootly_impact_source.terraform_acc_test
+}
+
+resource "sleuth_rootly_impact_source" "terraform_acc_test" {
+	project_slug     = sleuth_project.terraform_acc_test.slug
+	name             = "DataDog incidents"
+	environment_name = "Production"
+}`,
+				ExpectError: regexp.MustCompile(`Only rootly incident impact sources can be moved to\s+sleuth_rootly_impact_source`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+moved {
+	from = sleuth_incident_impact_source.terraform_acc_test
+	to   = sleuth_datadog_incident_impact_source.terraform_acc_test
+}
+
+resource "sleuth_datadog_incident_impact_source" "terraform_acc_test" {
+	project_slug              = sleuth_project.terraform_acc_test.slug
+	name                      = "DataDog incidents"
+	environment_name          = "Production"
+	query                     = "service:payments"
+	remote_priority_threshold = "P3"
+	integration_slug          = "datadog-prod"
+}`, projectString),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sleuth_datadog_incident_impact_source.terraform_acc_test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_datadog_incident_impact_source.terraform_acc_test", "slug", "datadog-incidents"),
+					resource.TestCheckResourceAttr("sleuth_datadog_incident_impact_source.terraform_acc_test", "query", "service:payments"),
+					resource.TestCheckResourceAttr("sleuth_datadog_incident_impact_source.terraform_acc_test", "remote_priority_threshold", "P3"),
+					resource.TestCheckResourceAttr("sleuth_datadog_incident_impact_source.terraform_acc_test", "integration_slug", "datadog-prod"),
+				),
+			},
+		},
+	})
+}
+
+func incidentProviderImpactSourcesConfig(projectName, remoteUrgency, remotePriorityThreshold string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_pagerduty_impact_source" "terraform_acc_test" {
+	project_slug     = sleuth_project.terraform_acc_test.slug
+	name             = "PagerDuty incidents"
+	environment_name = "Production"
+	remote_services  = "PIMPOA4"
+	remote_urgency   = "%s"
+}
+
+resource "sleuth_datadog_incident_impact_source" "terraform_acc_test" {
+	project_slug              = sleuth_project.terraform_acc_test.slug
+	name                      = "DataDog incidents"
+	environment_name          = "Production"
+	query                     = "service:payments"
+	remote_priority_threshold = "%s"
+}
+
+resource "sleuth_rootly_impact_source" "terraform_acc_test" {
+	project_slug     = sleuth_project.terraform_acc_test.slug
+	name             = "Rootly incidents"
+	environment_name = "Production"
+	remote_severity      = "HIGH"
+	remote_incident_type = ""
+	remote_environment   = ""
+	remote_service       = ""
+	remote_team          = "payments"
+}
+`, projectName, remoteUrgency, remotePriorityThreshold)
+}
+
+func incidentImpactSourceToMoveConfig(projectName string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+resource "sleuth_incident_impact_source" "terraform_acc_test" {
+	project_slug     = sleuth_project.terraform_acc_test.slug
+	name             = "DataDog incidents"
+	environment_name = "Production"
+	provider_name    = "datadog"
+	datadog_input = {
+		query                     = "service:payments"
+		remote_priority_threshold = "P3"
+		integration_slug          = "datadog-prod"
+	}
+}
+`, projectName)
+}
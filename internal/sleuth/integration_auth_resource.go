@@ -0,0 +1,409 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/validators"
+)
+
+var (
+	_ resource.Resource                   = &integrationAuthResource{}
+	_ resource.ResourceWithConfigure      = &integrationAuthResource{}
+	_ resource.ResourceWithImportState    = &integrationAuthResource{}
+	_ resource.ResourceWithValidateConfig = &integrationAuthResource{}
+)
+
+// integrationAuthProviders are the providers integrations can be connected for through the API, with the attribute
+// holding their credentials
+var integrationAuthProviders = []struct {
+	provider  string
+	attribute string
+}{
+	{provider: "DATADOG", attribute: "datadog_credentials"},
+	{provider: "PAGERDUTY", attribute: "pagerduty_credentials"},
+	{provider: "SENTRY", attribute: "sentry_credentials"},
+	{provider: "JENKINS", attribute: "jenkins_credentials"},
+	{provider: "CLOUDWATCH", attribute: "cloudwatch_credentials"},
+}
+
+type integrationAuthResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Slug types.String `tfsdk:"slug"`
+	Name types.String `tfsdk:"name"`
+
+	ProviderType caseInsensitiveStringValue `tfsdk:"provider_type"`
+	Label        types.String               `tfsdk:"label"`
+
+	DatadogCredentials    *datadogCredentialsModel    `tfsdk:"datadog_credentials"`
+	PagerDutyCredentials  *pagerDutyCredentialsModel  `tfsdk:"pagerduty_credentials"`
+	SentryCredentials     *sentryCredentialsModel     `tfsdk:"sentry_credentials"`
+	JenkinsCredentials    *jenkinsCredentialsModel    `tfsdk:"jenkins_credentials"`
+	CloudWatchCredentials *cloudWatchCredentialsModel `tfsdk:"cloudwatch_credentials"`
+}
+
+type datadogCredentialsModel struct {
+	APIKey types.String `tfsdk:"api_key"`
+	AppKey types.String `tfsdk:"app_key"`
+	Site   types.String `tfsdk:"site"`
+}
+
+type pagerDutyCredentialsModel struct {
+	APIKey types.String `tfsdk:"api_key"`
+}
+
+type sentryCredentialsModel struct {
+	AuthToken        types.String `tfsdk:"auth_token"`
+	OrganizationSlug types.String `tfsdk:"organization_slug"`
+	URL              types.String `tfsdk:"url"`
+}
+
+type jenkinsCredentialsModel struct {
+	URL      types.String `tfsdk:"url"`
+	Username types.String `tfsdk:"username"`
+	APIToken types.String `tfsdk:"api_token"`
+}
+
+type cloudWatchCredentialsModel struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Region          types.String `tfsdk:"region"`
+}
+
+type integrationAuthResource struct {
+	c *gqlclient.Client
+}
+
+func NewIntegrationAuthResource() resource.Resource {
+	return &integrationAuthResource{}
+}
+
+func (iar *integrationAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+	requiredSecret := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{MarkdownDescription: description, Required: true, Sensitive: true}
+	}
+	required := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{MarkdownDescription: description, Required: true}
+	}
+	optional := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{MarkdownDescription: description, Optional: true}
+	}
+
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Connects an integration to the organization with the given credentials, so impact sources and other resources can use it through its `slug`. Changing the credentials rotates them in place, Sleuth never returns them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Integration slug generated by Sleuth, the `integration_slug` of resources using the integration",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Integration name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "The provider of the integration, in any case (options: DATADOG, PAGERDUTY, SENTRY, JENKINS, CLOUDWATCH). Changing it connects a new integration.",
+				CustomType:          caseInsensitiveStringType{},
+				Required:            true,
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive(integrationAuthProviderTypes()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					caseInsensitiveRequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Description label telling apart several integrations of the same provider. Changing it connects a new integration.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datadog_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "Credentials of a `DATADOG` integration",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"api_key": requiredSecret("Datadog API key"),
+					"app_key": requiredSecret("Datadog application key"),
+					"site":    optional("Datadog site, e.g. `datadoghq.eu`. Defaults to `datadoghq.com`"),
+				},
+			},
+			"pagerduty_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "Credentials of a `PAGERDUTY` integration",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"api_key": requiredSecret("PagerDuty REST API key"),
+				},
+			},
+			"sentry_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "Credentials of a `SENTRY` integration",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"auth_token":        requiredSecret("Sentry authentication token"),
+					"organization_slug": required("Slug of the Sentry organization"),
+					"url":               optional("URL of a self-hosted Sentry. Defaults to `https://sentry.io`"),
+				},
+			},
+			"jenkins_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "Credentials of a `JENKINS` integration",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"url":       required("URL of the Jenkins server"),
+					"username":  required("Jenkins username"),
+					"api_token": requiredSecret("Jenkins API token of the user"),
+				},
+			},
+			"cloudwatch_credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "Credentials of a `CLOUDWATCH` integration",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"access_key_id":     required("AWS access key ID"),
+					"secret_access_key": requiredSecret("AWS secret access key"),
+					"region":            required("AWS region, e.g. `us-east-1`"),
+				},
+			},
+		},
+	}
+}
+
+func (iar *integrationAuthResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	iar.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (iar *integrationAuthResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_integration_auth"
+}
+
+// ValidateConfig validates that the credentials block of `provider_type`, and only that one, is set
+func (iar *integrationAuthResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var config integrationAuthResourceModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() || config.ProviderType.IsUnknown() {
+		return
+	}
+
+	provider := config.ProviderType.upper()
+	if !slices.Contains(integrationAuthProviderTypes(), provider) {
+		// reported by the validator of `provider_type`
+		return
+	}
+	configured := config.configuredCredentials()
+	for _, p := range integrationAuthProviders {
+		if p.provider == provider {
+			if !configured[p.attribute] {
+				res.Diagnostics.AddAttributeError(
+					path.Root(p.attribute),
+					"Missing credentials",
+					fmt.Sprintf("%s is required for %s integrations", p.attribute, provider),
+				)
+			}
+			continue
+		}
+		if configured[p.attribute] {
+			res.Diagnostics.AddAttributeError(
+				path.Root(p.attribute),
+				"Conflicting credentials",
+				fmt.Sprintf("%s cannot be set for %s integrations", p.attribute, provider),
+			)
+		}
+	}
+}
+
+// integrationAuthProviderTypes returns the providers of integrationAuthProviders
+func integrationAuthProviderTypes() []string {
+	var providers []string
+	for _, p := range integrationAuthProviders {
+		providers = append(providers, p.provider)
+	}
+	return providers
+}
+
+func (iar *integrationAuthResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	ctx = tflog.SetField(ctx, "resource", "integration_auth")
+	ctx = tflog.SetField(ctx, "operation", "create")
+
+	var plan integrationAuthResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Creating IntegrationAuth resource", map[string]any{"provider": plan.ProviderType.ValueString(), "label": plan.Label.ValueString()})
+	input := gqlclient.CreateIntegrationAuthMutationInput{
+		Provider:                   plan.ProviderType.upper(),
+		Label:                      plan.Label.ValueString(),
+		IntegrationAuthCredentials: plan.credentials(),
+	}
+	integration, err := iar.c.CreateIntegrationAuth(ctx, input)
+	if err != nil {
+		tflog.Error(ctx, "Error creating IntegrationAuth", map[string]any{"error": err.Error()})
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating integration authentication", err.Error(), err, integrationAuthFieldAliases)
+		return
+	}
+
+	setIntegrationAuthState(&plan, integration)
+	res.Diagnostics.Append(res.State.Set(ctx, plan)...)
+}
+
+func (iar *integrationAuthResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "resource", "integration_auth")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var state integrationAuthResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := iar.c.GetIntegration(ctx, state.Slug.ValueString())
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "IntegrationAuth not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		res.Diagnostics.AddError("Error reading integration authentication", err.Error())
+		return
+	}
+
+	// Sleuth never returns credentials, so the ones in state are kept
+	setIntegrationAuthState(&state, integration)
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
+
+// Update rotates the credentials, the only attributes that can change in place
+func (iar *integrationAuthResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	ctx = tflog.SetField(ctx, "resource", "integration_auth")
+	ctx = tflog.SetField(ctx, "operation", "update")
+
+	var plan, state integrationAuthResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Rotating IntegrationAuth credentials", map[string]any{"slug": state.Slug.ValueString()})
+	input := gqlclient.UpdateIntegrationAuthMutationInput{
+		Slug:                       state.Slug.ValueString(),
+		IntegrationAuthCredentials: plan.credentials(),
+	}
+	integration, err := iar.c.UpdateIntegrationAuth(ctx, input)
+	if err != nil {
+		tflog.Error(ctx, "Error updating IntegrationAuth", map[string]any{"error": err.Error()})
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating integration authentication", err.Error(), err, integrationAuthFieldAliases)
+		return
+	}
+
+	setIntegrationAuthState(&plan, integration)
+	res.Diagnostics.Append(res.State.Set(ctx, plan)...)
+}
+
+func (iar *integrationAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	ctx = tflog.SetField(ctx, "resource", "integration_auth")
+	ctx = tflog.SetField(ctx, "operation", "delete")
+
+	var state integrationAuthResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	err := iar.c.DeleteIntegrationAuth(ctx, state.Slug.ValueString())
+	if err != nil && !errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError("Error deleting integration authentication", err.Error())
+	}
+}
+
+// ImportState imports an integration by its slug. Its credentials are not returned by Sleuth, so the first apply
+// after the import sends the configured ones.
+func (iar *integrationAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, res)
+}
+
+// configuredCredentials returns which credentials attributes are set in m
+func (m integrationAuthResourceModel) configuredCredentials() map[string]bool {
+	return map[string]bool{
+		"datadog_credentials":    m.DatadogCredentials != nil,
+		"pagerduty_credentials":  m.PagerDutyCredentials != nil,
+		"sentry_credentials":     m.SentryCredentials != nil,
+		"jenkins_credentials":    m.JenkinsCredentials != nil,
+		"cloudwatch_credentials": m.CloudWatchCredentials != nil,
+	}
+}
+
+// credentials returns the credentials to send to the API
+func (m integrationAuthResourceModel) credentials() gqlclient.IntegrationAuthCredentials {
+	var credentials gqlclient.IntegrationAuthCredentials
+	if c := m.DatadogCredentials; c != nil {
+		credentials.Datadog = &gqlclient.DatadogCredentials{
+			APIKey: c.APIKey.ValueString(),
+			AppKey: c.AppKey.ValueString(),
+			Site:   c.Site.ValueString(),
+		}
+	}
+	if c := m.PagerDutyCredentials; c != nil {
+		credentials.PagerDuty = &gqlclient.PagerDutyCredentials{APIKey: c.APIKey.ValueString()}
+	}
+	if c := m.SentryCredentials; c != nil {
+		credentials.Sentry = &gqlclient.SentryCredentials{
+			AuthToken:        c.AuthToken.ValueString(),
+			OrganizationSlug: c.OrganizationSlug.ValueString(),
+			Url:              c.URL.ValueString(),
+		}
+	}
+	if c := m.JenkinsCredentials; c != nil {
+		credentials.Jenkins = &gqlclient.JenkinsCredentials{
+			Url:      c.URL.ValueString(),
+			Username: c.Username.ValueString(),
+			APIToken: c.APIToken.ValueString(),
+		}
+	}
+	if c := m.CloudWatchCredentials; c != nil {
+		credentials.CloudWatch = &gqlclient.CloudWatchCredentials{
+			AccessKeyID:     c.AccessKeyID.ValueString(),
+			SecretAccessKey: c.SecretAccessKey.ValueString(),
+			Region:          c.Region.ValueString(),
+		}
+	}
+	return credentials
+}
+
+// setIntegrationAuthState sets the attributes returned by the API on m, leaving the credentials alone
+func setIntegrationAuthState(m *integrationAuthResourceModel, integration *gqlclient.IntegrationAuthentication) {
+	m.ID = types.StringValue(integration.Slug)
+	m.Slug = types.StringValue(integration.Slug)
+	m.Name = types.StringValue(integration.Name)
+	m.ProviderType = newCaseInsensitiveStringValue(integration.Provider)
+	m.Label = types.StringNull()
+	if integration.Label != "" {
+		m.Label = types.StringValue(integration.Label)
+	}
+}
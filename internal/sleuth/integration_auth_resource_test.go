@@ -0,0 +1,88 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIntegrationAuthResource(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random label so slugs don't collide
+	label := fmt.Sprintf("terraform-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sleuth_integration_auth" "terraform_acc_test" {
+	provider_type = "GITHUB"
+}
+`,
+				ExpectError: regexp.MustCompile(`provider_type value must be one of: "DATADOG", "PAGERDUTY", "SENTRY",\s+"JENKINS", "CLOUDWATCH", in any case, got: "GITHUB"`),
+			},
+			{
+				Config: `
+resource "sleuth_integration_auth" "terraform_acc_test" {
+	provider_type = "DATADOG"
+	pagerduty_credentials = {
+		api_key = "secret"
+	}
+}
+`,
+				ExpectError: regexp.MustCompile(`(?s)datadog_credentials is required for DATADOG integrations.*pagerduty_credentials cannot be set\s+for DATADOG integrations`),
+			},
+			{
+				Config: integrationAuthConfig(label, "first-token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_integration_auth.terraform_acc_test", "slug", "jenkins-"+label),
+					resource.TestCheckResourceAttr("sleuth_integration_auth.terraform_acc_test", "name", "JENKINS "+label),
+					resource.TestCheckResourceAttr("sleuth_integration_auth.terraform_acc_test", "provider_type", "jenkins"),
+					resource.TestCheckResourceAttr("sleuth_integration_auth.terraform_acc_test", "jenkins_credentials.api_token", "first-token"),
+				),
+			},
+			// rotating the credentials keeps the integration, and its slug
+			{
+				Config: integrationAuthConfig(label, "second-token"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sleuth_integration_auth.terraform_acc_test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_integration_auth.terraform_acc_test", "slug", "jenkins-"+label),
+					resource.TestCheckResourceAttr("sleuth_integration_auth.terraform_acc_test", "jenkins_credentials.api_token", "second-token"),
+				),
+			},
+			{
+				ResourceName:      "sleuth_integration_auth.terraform_acc_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API never returns credentials, and reports the provider in uppercase
+				ImportStateVerifyIgnore: []string{"jenkins_credentials", "provider_type"},
+			},
+		},
+	})
+}
+
+func integrationAuthConfig(label, apiToken string) string {
+	return fmt.Sprintf(`
+resource "sleuth_integration_auth" "terraform_acc_test" {
+	provider_type = "jenkins"
+	label         = "%s"
+	jenkins_credentials = {
+		url       = "https://jenkins.example.com"
+		username  = "terraform"
+		api_token = "%s"
+	}
+}
+`, label, apiToken)
+}
@@ -0,0 +1,185 @@
+package sleuth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource                   = &integrationDataSource{}
+	_ datasource.DataSourceWithConfigure      = &integrationDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &integrationDataSource{}
+)
+
+type integrationDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	integrationModel
+}
+
+type integrationDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewIntegrationDataSource() datasource.DataSource {
+	return &integrationDataSource{}
+}
+
+func (i *integrationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an integration connected to the organization by its slug, or by its provider and description label, so resources can reference its slug instead of copying it from Sleuth. It is an error if no integration or more than one matches.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Integration slug, the `integration_slug` of resources using the integration",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Integration name",
+				Computed:            true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "The provider of the integration, e.g. `DATADOG`, in any case. Required unless `slug` is set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The description label of the integration, in any case. Only needed when the organization has several integrations of the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (i *integrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	i.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (i *integrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (i *integrationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, res *datasource.ValidateConfigResponse) {
+	var config integrationDataSourceModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Slug.IsNull() && config.ProviderType.IsNull() {
+		res.Diagnostics.AddAttributeError(
+			path.Root("provider_type"),
+			"Missing integration filter",
+			"Set provider_type, and label if there are several integrations of the provider, or slug to look up an integration",
+		)
+	}
+}
+
+func (i *integrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "integration")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config integrationDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := i.c.GetIntegrations(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error obtaining integrations: %+v", err))
+		res.Diagnostics.AddError("Error Reading Integration", fmt.Sprintf("Could not list integrations, %+v", err.Error()))
+		return
+	}
+
+	matching := filterIntegrations(integrations, config.ProviderType.ValueString(), config.Label.ValueString())
+	if !config.Slug.IsNull() {
+		var withSlug []gqlclient.IntegrationAuthentication
+		for _, integration := range matching {
+			if integration.Slug == config.Slug.ValueString() {
+				withSlug = append(withSlug, integration)
+			}
+		}
+		matching = withSlug
+	}
+
+	switch len(matching) {
+	case 0:
+		res.Diagnostics.AddError(
+			"Integration not found",
+			fmt.Sprintf("No integration matches %s, connect it in Sleuth first. Integrations of the organization: %s",
+				describeIntegrationFilter(config.integrationModel), integrationSlugs(integrations)),
+		)
+		return
+	case 1:
+	default:
+		res.Diagnostics.AddError(
+			"Multiple integrations found",
+			fmt.Sprintf("%d integrations match %s: %s. Set label or slug to pick one.",
+				len(matching), describeIntegrationFilter(config.integrationModel), integrationSlugs(matching)),
+		)
+		return
+	}
+
+	state := integrationDataSourceModel{
+		ID:               types.StringValue(matching[0].Slug),
+		integrationModel: getNewStateFromIntegration(matching[0]),
+	}
+	// configured filters are kept in the case they were written in
+	if !config.ProviderType.IsNull() {
+		state.ProviderType = config.ProviderType
+	}
+	if !config.Label.IsNull() {
+		state.Label = config.Label
+	}
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
+
+// describeIntegrationFilter describes the configured filters of the data source, for error messages
+func describeIntegrationFilter(config integrationModel) string {
+	var filters []string
+	for _, filter := range []struct {
+		name  string
+		value types.String
+	}{
+		{name: "slug", value: config.Slug},
+		{name: "provider_type", value: config.ProviderType},
+		{name: "label", value: config.Label},
+	} {
+		if !filter.value.IsNull() {
+			filters = append(filters, fmt.Sprintf("%s %q", filter.name, filter.value.ValueString()))
+		}
+	}
+	return strings.Join(filters, " and ")
+}
+
+func integrationSlugs(integrations []gqlclient.IntegrationAuthentication) string {
+	if len(integrations) == 0 {
+		return "none"
+	}
+	slugs := make([]string, 0, len(integrations))
+	for _, integration := range integrations {
+		slugs = append(slugs, integration.Slug)
+	}
+	return strings.Join(slugs, ", ")
+}
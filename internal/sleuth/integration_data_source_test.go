@@ -0,0 +1,64 @@
+package sleuth
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIntegrationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      `data "sleuth_integration" "missing_filter" {}`,
+				ExpectError: regexp.MustCompile(`Missing integration filter`),
+			},
+			{
+				Config: `
+data "sleuth_integration" "datadog" {
+	provider_type = "DATADOG"
+}
+`,
+				ExpectError: regexp.MustCompile(`2 integrations match provider_type "DATADOG": datadog-prod,\s+datadog-staging`),
+			},
+			{
+				Config: `
+data "sleuth_integration" "sentry" {
+	provider_type = "SENTRY"
+}
+`,
+				ExpectError: regexp.MustCompile(`Integration not found`),
+			},
+			{
+				Config: `
+data "sleuth_integration" "datadog_prod" {
+	provider_type = "datadog"
+	label         = "prod"
+}
+
+data "sleuth_integration" "pagerduty" {
+	provider_type = "PAGERDUTY"
+}
+
+data "sleuth_integration" "by_slug" {
+	slug = "datadog-staging"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_integration.datadog_prod", "slug", "datadog-prod"),
+					resource.TestCheckResourceAttr("data.sleuth_integration.datadog_prod", "provider_type", "datadog"),
+					resource.TestCheckResourceAttr("data.sleuth_integration.pagerduty", "slug", "pagerduty"),
+					resource.TestCheckResourceAttr("data.sleuth_integration.pagerduty", "label", ""),
+					resource.TestCheckResourceAttr("data.sleuth_integration.by_slug", "provider_type", "DATADOG"),
+					resource.TestCheckResourceAttr("data.sleuth_integration.by_slug", "label", "staging"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package sleuth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &integrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &integrationsDataSource{}
+)
+
+type integrationsDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	ProviderType types.String       `tfsdk:"provider_type"`
+	Label        types.String       `tfsdk:"label"`
+	Slugs        types.List         `tfsdk:"slugs"`
+	Integrations []integrationModel `tfsdk:"integrations"`
+}
+
+type integrationModel struct {
+	Slug         types.String `tfsdk:"slug"`
+	Name         types.String `tfsdk:"name"`
+	ProviderType types.String `tfsdk:"provider_type"`
+	Label        types.String `tfsdk:"label"`
+}
+
+type integrationsDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &integrationsDataSource{}
+}
+
+func (i *integrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Lists the integrations connected to the organization, optionally filtered by provider and description label. Their slugs are what the `integration_slug` of resources refer to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Only return integrations of this provider, e.g. `DATADOG`, in any case",
+				Optional:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Only return integrations with this description label, in any case",
+				Optional:            true,
+			},
+			"slugs": schema.ListAttribute{
+				MarkdownDescription: "Slugs of the matching integrations",
+				ElementType:         basetypes.StringType{},
+				Computed:            true,
+			},
+			"integrations": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching integrations",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: integrationAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// integrationAttributes are the attributes describing an integration, shared with the `sleuth_integration` data
+// source
+func integrationAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "Integration slug, the `integration_slug` of resources using the integration",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Integration name",
+			Computed:            true,
+		},
+		"provider_type": schema.StringAttribute{
+			MarkdownDescription: "The provider of the integration",
+			Computed:            true,
+		},
+		"label": schema.StringAttribute{
+			MarkdownDescription: "The description label of the integration, which tells apart integrations of the same provider",
+			Computed:            true,
+		},
+	}
+}
+
+func (i *integrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	i.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (i *integrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+func (i *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "integrations")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config integrationsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := i.c.GetIntegrations(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error obtaining integrations: %+v", err))
+		res.Diagnostics.AddError("Error Reading Integrations", fmt.Sprintf("Could not list integrations, %+v", err.Error()))
+		return
+	}
+
+	matching := filterIntegrations(integrations, config.ProviderType.ValueString(), config.Label.ValueString())
+	tflog.Info(ctx, "Read Integrations data source", map[string]any{"total": len(integrations), "matching": len(matching)})
+
+	state := integrationsDataSourceModel{
+		ID:           types.StringValue("integrations"),
+		ProviderType: config.ProviderType,
+		Label:        config.Label,
+		Integrations: []integrationModel{},
+	}
+	slugs := []attr.Value{}
+	for _, integration := range matching {
+		state.Integrations = append(state.Integrations, getNewStateFromIntegration(integration))
+		slugs = append(slugs, types.StringValue(integration.Slug))
+	}
+	state.Slugs, diags = types.ListValue(basetypes.StringType{}, slugs)
+	res.Diagnostics.Append(diags...)
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
+
+func getNewStateFromIntegration(integration gqlclient.IntegrationAuthentication) integrationModel {
+	return integrationModel{
+		Slug:         types.StringValue(integration.Slug),
+		Name:         types.StringValue(integration.Name),
+		ProviderType: types.StringValue(integration.Provider),
+		Label:        types.StringValue(integration.Label),
+	}
+}
+
+// filterIntegrations returns the integrations of providerType with label, ignoring case. Empty filters match any
+// integration.
+func filterIntegrations(integrations []gqlclient.IntegrationAuthentication, providerType, label string) []gqlclient.IntegrationAuthentication {
+	var matching []gqlclient.IntegrationAuthentication
+	for _, integration := range integrations {
+		if providerType != "" && !strings.EqualFold(integration.Provider, providerType) {
+			continue
+		}
+		if label != "" && !strings.EqualFold(integration.Label, label) {
+			continue
+		}
+		matching = append(matching, integration)
+	}
+	return matching
+}
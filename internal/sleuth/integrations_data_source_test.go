@@ -0,0 +1,72 @@
+package sleuth
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+func TestAccIntegrationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "sleuth_integrations" "all" {}
+
+data "sleuth_integrations" "datadog" {
+	provider_type = "datadog"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_integrations.all", "slugs.#", "3"),
+					resource.TestCheckResourceAttr("data.sleuth_integrations.datadog", "slugs.#", "2"),
+					resource.TestCheckResourceAttr("data.sleuth_integrations.datadog", "slugs.0", "datadog-prod"),
+					resource.TestCheckResourceAttr("data.sleuth_integrations.datadog", "integrations.1.label", "staging"),
+					resource.TestCheckResourceAttr("data.sleuth_integrations.datadog", "integrations.1.provider_type", "DATADOG"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterIntegrations(t *testing.T) {
+	integrations := []gqlclient.IntegrationAuthentication{
+		{Slug: "datadog-prod", Provider: "DATADOG", Label: "prod"},
+		{Slug: "datadog-staging", Provider: "DATADOG", Label: "staging"},
+		{Slug: "cloudwatch-prod", Provider: "CLOUDWATCH", Label: "Prod"},
+		{Slug: "pagerduty", Provider: "PAGERDUTY"},
+	}
+
+	tests := []struct {
+		name         string
+		providerType string
+		label        string
+		expected     []string
+	}{
+		{name: "no filters", expected: []string{"datadog-prod", "datadog-staging", "cloudwatch-prod", "pagerduty"}},
+		{name: "provider in any case", providerType: "datadog", expected: []string{"datadog-prod", "datadog-staging"}},
+		{name: "label in any case", label: "prod", expected: []string{"datadog-prod", "cloudwatch-prod"}},
+		{name: "provider and label", providerType: "DATADOG", label: "staging", expected: []string{"datadog-staging"}},
+		{name: "unknown provider", providerType: "SENTRY", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var slugs []string
+			for _, integration := range filterIntegrations(integrations, tt.providerType, tt.label) {
+				slugs = append(slugs, integration.Slug)
+			}
+			if diff := cmp.Diff(tt.expected, slugs); diff != "" {
+				t.Errorf("unexpected integrations (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
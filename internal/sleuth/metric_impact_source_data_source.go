@@ -0,0 +1,125 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &metricImpactSourceDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricImpactSourceDataSource{}
+)
+
+type metricImpactSourceDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewMetricImpactSourceDataSource() datasource.DataSource {
+	return &metricImpactSourceDataSource{}
+}
+
+func (misd *metricImpactSourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing Sleuth metric impact source by project and slug.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the project that this metric impact source belongs to.",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Metric impact source slug",
+				Required:            true,
+			},
+			"environment_slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the environment that this metric impact source belongs to.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Impact source name",
+				Computed:            true,
+			},
+			"provider_type": schema.StringAttribute{
+				MarkdownDescription: "Integration provider type",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"integration_slug": schema.StringAttribute{
+				MarkdownDescription: "The integration slug",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The metric query",
+				Computed:            true,
+			},
+			"less_is_better": schema.BoolAttribute{
+				MarkdownDescription: "Whether smaller values are better or not",
+				Computed:            true,
+			},
+			"manually_set_health_threshold": schema.Float64Attribute{
+				MarkdownDescription: "The manually set threshold to start marking failed values",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (misd *metricImpactSourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	misd.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (misd *metricImpactSourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_metric_impact_source"
+}
+
+func (misd *metricImpactSourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "metric_impact_source")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config metricImpactResourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	projectSlug := config.ProjectSlug.ValueString()
+	slug := config.Slug.ValueString()
+	tflog.Info(ctx, "Reading MetricImpactSource data source", map[string]any{"projectSlug": projectSlug, "slug": slug})
+
+	mis, err := misd.c.GetMetricImpactSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError(
+			"MetricImpactSource not found",
+			fmt.Sprintf("Could not find metric impact source %s in project %s", slug, projectSlug),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, "Error reading MetricImpactSource", map[string]any{"error": err.Error()})
+		res.Diagnostics.AddError(
+			"Error reading MetricImpactSource",
+			fmt.Sprintf("Could not read metric impact source %s/%s: %+v", projectSlug, slug, err.Error()),
+		)
+		return
+	}
+
+	state := getNewStateFromMetricImpactSource(mis, projectSlug)
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
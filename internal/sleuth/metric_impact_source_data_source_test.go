@@ -0,0 +1,44 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccMetricImpactSourceDataSource_v6(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random name so slugs don't collide
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectString := fmt.Sprintf("Terraform test project %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version1_0_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: metricImpactSourceDataSourceConfig(projectString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_metric_impact_source.terraform_acc_test", "name", "Datadog acceptance test"),
+					resource.TestMatchResourceAttr("data.sleuth_metric_impact_source.terraform_acc_test", "provider_type", regexp.MustCompile(`(?i)^DATADOG$`)),
+					resource.TestCheckResourceAttr("data.sleuth_metric_impact_source.terraform_acc_test", "less_is_better", "false"),
+					resource.TestCheckResourceAttrPair("data.sleuth_metric_impact_source.terraform_acc_test", "query", "sleuth_metric_impact_source.terraform_acc_test_dd", "query"),
+				),
+			},
+		},
+	})
+}
+
+func metricImpactSourceDataSourceConfig(name string) string {
+	return createMetricImpactConfig(name) + `
+data "sleuth_metric_impact_source" "terraform_acc_test" {
+	project_slug = sleuth_project.terraform_acc_test.slug
+	slug = sleuth_metric_impact_source.terraform_acc_test_dd.slug
+}
+`
+}
@@ -2,26 +2,31 @@ package sleuth
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/validators"
 )
 
 var (
 	_ resource.Resource                = &metricImpactSourceResource{}
 	_ resource.ResourceWithConfigure   = &metricImpactSourceResource{}
 	_ resource.ResourceWithImportState = &metricImpactSourceResource{}
+	_ resource.ResourceWithModifyPlan  = &metricImpactSourceResource{}
 )
 
+var metricProviders = []string{"DATADOG", "NEW_RELIC", "CLOUDWATCH", "PROMETHEUS", "APPDYNAMICS", "SIGNALFX"}
+
 type metricImpactResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Slug types.String `tfsdk:"slug"`
@@ -29,12 +34,12 @@ type metricImpactResourceModel struct {
 	ProjectSlug types.String `tfsdk:"project_slug"`
 	EnvSlug     types.String `tfsdk:"environment_slug"`
 
-	Name                       types.String  `tfsdk:"name"`
-	ProviderType               types.String  `tfsdk:"provider_type"`
-	IntegrationSlug            types.String  `tfsdk:"integration_slug"`
-	Query                      types.String  `tfsdk:"query"`
-	LessIsBetter               types.Bool    `tfsdk:"less_is_better"`
-	ManuallySetHealthThreshold types.Float64 `tfsdk:"manually_set_health_threshold"`
+	Name                       types.String               `tfsdk:"name"`
+	ProviderType               caseInsensitiveStringValue `tfsdk:"provider_type"`
+	IntegrationSlug            types.String               `tfsdk:"integration_slug"`
+	Query                      types.String               `tfsdk:"query"`
+	LessIsBetter               types.Bool                 `tfsdk:"less_is_better"`
+	ManuallySetHealthThreshold types.Float64              `tfsdk:"manually_set_health_threshold"`
 }
 
 type metricImpactSourceResource struct {
@@ -72,11 +77,15 @@ func (misr *metricImpactSourceResource) Schema(_ context.Context, _ resource.Sch
 			},
 
 			"provider_type": schema.StringAttribute{
-				MarkdownDescription: "Integration provider type",
+				MarkdownDescription: "Integration provider type - options: DATADOG, NEW_RELIC, CLOUDWATCH, PROMETHEUS, APPDYNAMICS, SIGNALFX.",
+				CustomType:          caseInsensitiveStringType{},
 				Required:            true,
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive(metricProviders...),
+				},
 			},
 			"integration_slug": schema.StringAttribute{
-				MarkdownDescription: "Integration slug is generated automatically when an integration is set up in Sleuth. By default, it matches the `provider_type`. Any value specified in the integration's `Description label` field gets appended to the `integration_slug`, spaces replaced with dashes, e.g. `cloudwatch-test`",
+				MarkdownDescription: "Integration slug is generated automatically when an integration is set up in Sleuth. By default, it matches the `provider_type`. Any value specified in the integration's `Description label` field gets appended to the `integration_slug`, spaces replaced with dashes, e.g. `cloudwatch-test`. The `sleuth_integration` data source looks it up.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -109,6 +118,24 @@ func (misr *metricImpactSourceResource) Metadata(ctx context.Context, req resour
 	res.TypeName = req.ProviderTypeName + "_metric_impact_source"
 }
 
+// ModifyPlan fails the plan when the project, environment or integration of the source does not exist, see
+// validate_references_on_plan
+func (misr *metricImpactSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	references, ok := newReferenceValidator(misr.c, req, res)
+	if !ok {
+		return
+	}
+	var plan metricImpactResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	if references.project(ctx, path.Root("project_slug"), plan.ProjectSlug) {
+		references.environment(ctx, path.Root("environment_slug"), plan.ProjectSlug.ValueString(), plan.EnvSlug)
+	}
+	references.integration(ctx, path.Root("integration_slug"), plan.IntegrationSlug)
+}
+
 func (misr *metricImpactSourceResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
 	ctx = tflog.SetField(ctx, "resource", "metric_impact_source")
 	ctx = tflog.SetField(ctx, "operation", "create")
@@ -135,10 +162,7 @@ func (misr *metricImpactSourceResource) Create(ctx context.Context, req resource
 	mis, err := misr.c.CreateMetricImpactSource(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error creating MetricImpactSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error creating MetricImpactSource",
-			fmt.Sprintf("Could not create metric impact source, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating MetricImpactSource", fmt.Sprintf("Could not create metric impact source, unexpected error: %+v", err.Error()), err, impactSourceFieldAliases)
 		return
 	}
 
@@ -161,15 +185,12 @@ func (misr *metricImpactSourceResource) Read(ctx context.Context, req resource.R
 	projectSlug := state.ProjectSlug.ValueString()
 	slug := state.Slug.ValueString()
 
-	// when importing a resource, only ID will be set with project slug & slug
-	if projectSlug == "" {
-		id := state.ID.ValueString()
-		splits := strings.Split(id, "/")
-		projectSlug = splits[0]
-		slug = splits[1]
-	}
-
 	ccs, err := misr.c.GetMetricImpactSource(ctx, &projectSlug, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "MetricImpactSource not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		tflog.Error(ctx, "Error reading MetricImpactSource", map[string]any{"error": err.Error()})
 		res.Diagnostics.AddError(
@@ -212,10 +233,7 @@ func (misr *metricImpactSourceResource) Update(ctx context.Context, req resource
 	ccs, err := misr.c.UpdateMetricImpactSource(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error updating MetricImpactSource", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error updating MetricImpactSource",
-			fmt.Sprintf("Could not update metric impact source, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating MetricImpactSource", fmt.Sprintf("Could not update metric impact source, unexpected error: %+v", err.Error()), err, impactSourceFieldAliases)
 		return
 	}
 
@@ -251,7 +269,14 @@ func (misr *metricImpactSourceResource) Delete(ctx context.Context, req resource
 }
 
 func (misr *metricImpactSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	// metric impact sources are imported by `project_slug/slug` or `project_slug/name:<Name>`
+	importProjectScopedResource(ctx, req, res, "MetricImpactSource", func(ctx context.Context, projectSlug, name string) (string, error) {
+		mis, err := misr.c.GetMetricImpactSourceByName(ctx, &projectSlug, &name)
+		if err != nil {
+			return "", err
+		}
+		return mis.Slug, nil
+	})
 }
 
 func getNewStateFromMetricImpactSource(ccs *gqlclient.MetricImpactSource, projectSlug string) metricImpactResourceModel {
@@ -261,7 +286,7 @@ func getNewStateFromMetricImpactSource(ccs *gqlclient.MetricImpactSource, projec
 		ProjectSlug:                types.StringValue(projectSlug),
 		EnvSlug:                    types.StringValue(ccs.Environment.Slug),
 		Name:                       types.StringValue(ccs.Name),
-		ProviderType:               types.StringValue(strings.ToUpper(ccs.Provider)),
+		ProviderType:               newCaseInsensitiveStringValue(ccs.Provider),
 		IntegrationSlug:            types.StringValue(ccs.IntegrationAuthSlug),
 		Query:                      types.StringValue(ccs.Query),
 		LessIsBetter:               types.BoolValue(ccs.LessIsBetter),
@@ -273,7 +298,7 @@ func getMutableMetricImpactSourceStruct(plan metricImpactResourceModel) *gqlclie
 	return &gqlclient.MutableMetricImpactSource{
 		EnvironmentSlug:            plan.EnvSlug.ValueString(),
 		Name:                       plan.Name.ValueString(),
-		Provider:                   strings.ToUpper(plan.ProviderType.ValueString()),
+		Provider:                   plan.ProviderType.upper(),
 		Query:                      plan.Query.ValueString(),
 		IntegrationSlug:            plan.IntegrationSlug.ValueString(),
 		LessIsBetter:               plan.LessIsBetter.ValueBool(),
@@ -84,6 +84,19 @@ func TestAccMetricImpactSourceResource_v6(t *testing.T) {
 					resource.TestCheckResourceAttr("sleuth_metric_impact_source.terraform_acc_test_cw", "less_is_better", "false"),
 				),
 			},
+			// ImportState testing
+			{
+				ResourceName:      "sleuth_metric_impact_source.terraform_acc_test_dd",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportID("sleuth_metric_impact_source.terraform_acc_test_dd"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sleuth_metric_impact_source.terraform_acc_test_cw",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportIDByName("sleuth_metric_impact_source.terraform_acc_test_cw"),
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
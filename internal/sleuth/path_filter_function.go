@@ -0,0 +1,87 @@
+package sleuth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &pathFilterFunction{}
+)
+
+type pathFilterFunction struct{}
+
+func NewPathFilterFunction() function.Function {
+	return &pathFilterFunction{}
+}
+
+func (f *pathFilterFunction) Metadata(_ context.Context, _ function.MetadataRequest, res *function.MetadataResponse) {
+	res.Name = "path_filter"
+}
+
+func (f *pathFilterFunction) Definition(_ context.Context, _ function.DefinitionRequest, res *function.DefinitionResponse) {
+	res.Definition = function.Definition{
+		Summary: "Builds the `path_prefix` of a code change source",
+		MarkdownDescription: "Returns the JSON document the `path_prefix` attribute of `sleuth_code_change_source` expects, limiting the source to changes in the `include` paths and ignoring changes in the `exclude` paths. " +
+			"Paths are globs relative to the repository root, e.g. `services/api/*`. A malformed glob, such as one with an unclosed `[`, is an error. " +
+			"Pass `null` or an empty list for either argument to leave it empty. " +
+			"The `path_filters` attribute takes the same lists directly and is preferred over the deprecated `path_prefix`.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "include",
+				MarkdownDescription: "Paths whose changes are tracked",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.ListParameter{
+				Name:                "exclude",
+				MarkdownDescription: "Paths whose changes are ignored",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *pathFilterFunction) Run(ctx context.Context, req function.RunRequest, res *function.RunResponse) {
+	var include, exclude []types.String
+	res.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &include, &exclude))
+	if res.Error != nil {
+		return
+	}
+
+	includes, funcErr := parsePathGlobs(0, include)
+	res.Error = function.ConcatFuncErrors(res.Error, funcErr)
+	excludes, funcErr := parsePathGlobs(1, exclude)
+	res.Error = function.ConcatFuncErrors(res.Error, funcErr)
+	if res.Error != nil {
+		return
+	}
+
+	filter, err := encodePathFilter(pathFilter{Excludes: excludes, Includes: includes})
+	if err != nil {
+		res.Error = function.NewFuncError(fmt.Sprintf("Could not encode path filter: %s", err))
+		return
+	}
+
+	res.Error = function.ConcatFuncErrors(res.Result.Set(ctx, filter))
+}
+
+// parsePathGlobs validates the globs passed as the argument at position argument, a null list is empty
+func parsePathGlobs(argument int64, globs []types.String) ([]string, *function.FuncError) {
+	parsed := make([]string, 0, len(globs))
+	for i, glob := range globs {
+		if glob.IsNull() {
+			return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("Path %d must not be null", i))
+		}
+		if err := validatePathGlob(glob.ValueString()); err != nil {
+			return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("Path %d is invalid: %s", i, err))
+		}
+		parsed = append(parsed, glob.ValueString())
+	}
+	return parsed, nil
+}
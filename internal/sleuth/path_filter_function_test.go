@@ -0,0 +1,91 @@
+package sleuth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPathFilterFunctionRun(t *testing.T) {
+	stringList := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	nullList := types.ListNull(types.StringType)
+
+	tests := []struct {
+		name     string
+		include  types.List
+		exclude  types.List
+		expected string
+		err      bool
+		errArg   int64
+	}{
+		{name: "include and exclude", include: stringList("services/api/*", "libs/**"), exclude: stringList("docs/*"), expected: `{"excludes":["docs/*"],"includes":["services/api/*","libs/**"]}`},
+		{name: "only include", include: stringList("services/api"), exclude: nullList, expected: `{"excludes":[],"includes":["services/api"]}`},
+		{name: "only exclude", include: stringList(), exclude: stringList("docs"), expected: `{"excludes":["docs"],"includes":[]}`},
+		{name: "escaped like jsonencode", include: stringList("a&b/<c>"), exclude: nullList, expected: `{"excludes":[],"includes":["a\u0026b/\u003cc\u003e"]}`},
+		{name: "empty path", include: stringList("services", ""), exclude: nullList, err: true, errArg: 0},
+		{name: "malformed glob", include: nullList, exclude: stringList("services/[api"), err: true, errArg: 1},
+		{name: "malformed glob after mismatch", include: stringList("x\\"), exclude: nullList, err: true, errArg: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewPathFilterFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{tt.include, tt.exclude}),
+			}, &res)
+			if tt.err {
+				if res.Error == nil || res.Error.FunctionArgument == nil || *res.Error.FunctionArgument != tt.errArg {
+					t.Fatalf("expected an error for argument %d, got %v", tt.errArg, res.Error)
+				}
+				return
+			}
+			if res.Error != nil {
+				t.Fatal(res.Error)
+			}
+			if filter := res.Result.Value(); !filter.Equal(types.StringValue(tt.expected)) {
+				t.Errorf("expected %s, got %s", tt.expected, filter)
+			}
+		})
+	}
+}
+
+func TestAccPathFilterFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// provider-defined functions were added in Terraform 1.8
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "path_filter" {
+	value = provider::sleuth::path_filter(["services/api/*"], ["services/api/docs/*"])
+}
+
+output "jsonencode" {
+	value = jsonencode({
+		excludes = ["services/api/docs/*"]
+		includes = ["services/api/*"]
+	})
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("path_filter", `{"excludes":["services/api/docs/*"],"includes":["services/api/*"]}`),
+					resource.TestCheckOutput("jsonencode", `{"excludes":["services/api/docs/*"],"includes":["services/api/*"]}`),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,226 @@
+package sleuth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// pathFilter is the JSON document `path_prefix` of a code change source holds. Fields are in the order
+// jsonencode() sorts them in, so the encoded document matches a hand-written jsonencode() of the same lists.
+type pathFilter struct {
+	Excludes []string `json:"excludes"`
+	Includes []string `json:"includes"`
+}
+
+// validatePathGlob returns an error if glob is empty or malformed
+func validatePathGlob(glob string) error {
+	if glob == "" {
+		return errors.New("path must not be empty")
+	}
+	if _, err := path.Match(glob, ""); err != nil {
+		return fmt.Errorf("path %q is not a valid glob: %w", glob, err)
+	}
+	return nil
+}
+
+// parsePathFilter decodes a `path_prefix` holding a path filter document. It returns false for anything else, like
+// an empty `path_prefix` or a plain path prefix.
+func parsePathFilter(pathPrefix string) (pathFilter, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(pathPrefix), &fields); err != nil {
+		return pathFilter{}, false
+	}
+	var filter pathFilter
+	for key, value := range fields {
+		var target *[]string
+		switch key {
+		case "excludes":
+			target = &filter.Excludes
+		case "includes":
+			target = &filter.Includes
+		default:
+			return pathFilter{}, false
+		}
+		if err := json.Unmarshal(value, target); err != nil {
+			return pathFilter{}, false
+		}
+	}
+	return filter, true
+}
+
+// encodePathFilter encodes filter the way jsonencode() would, with missing lists as empty ones
+func encodePathFilter(filter pathFilter) (string, error) {
+	if filter.Excludes == nil {
+		filter.Excludes = []string{}
+	}
+	if filter.Includes == nil {
+		filter.Includes = []string{}
+	}
+	encoded, err := json.Marshal(filter)
+	return string(encoded), err
+}
+
+// jsonSemanticallyEqual reports whether a and b are the same JSON document, ignoring formatting and key order.
+// Strings that are not JSON are compared as they are.
+func jsonSemanticallyEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var aValue, bValue any
+	if json.Unmarshal([]byte(a), &aValue) != nil || json.Unmarshal([]byte(b), &bValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+type pathFiltersResourceModel struct {
+	Include types.List `tfsdk:"include"`
+	Exclude types.List `tfsdk:"exclude"`
+}
+
+func (p pathFiltersResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"include": types.ListType{ElemType: types.StringType},
+		"exclude": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// pathFiltersToPathFilter converts a known `path_filters` object to the document sent as `path_prefix`
+func pathFiltersToPathFilter(ctx context.Context, pathFilters types.Object) (pathFilter, diag.Diagnostics) {
+	var model pathFiltersResourceModel
+	diags := pathFilters.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return pathFilter{}, diags
+	}
+
+	var filter pathFilter
+	diags.Append(model.Include.ElementsAs(ctx, &filter.Includes, false)...)
+	diags.Append(model.Exclude.ElementsAs(ctx, &filter.Excludes, false)...)
+	return filter, diags
+}
+
+// getPathFiltersValue returns the `path_filters` object for the path prefix returned by the API. The planned or prior
+// object is kept when it encodes to the same document, so an empty list is not replaced by a null one or vice versa.
+// It is null when the path prefix is not a path filter document.
+func getPathFiltersValue(ctx context.Context, pathPrefix string, prior types.Object) (types.Object, diag.Diagnostics) {
+	attributeTypes := pathFiltersResourceModel{}.AttributeTypes()
+
+	filter, ok := parsePathFilter(pathPrefix)
+	if !ok {
+		return types.ObjectNull(attributeTypes), nil
+	}
+
+	if !prior.IsNull() && isFullyKnown(ctx, prior) {
+		priorFilter, diags := pathFiltersToPathFilter(ctx, prior)
+		if diags.HasError() {
+			return types.ObjectNull(attributeTypes), diags
+		}
+		encodedPrior, err := encodePathFilter(priorFilter)
+		if err == nil && jsonSemanticallyEqual(encodedPrior, pathPrefix) {
+			return prior, nil
+		}
+	}
+
+	model := pathFiltersResourceModel{
+		Include: types.ListNull(types.StringType),
+		Exclude: types.ListNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	if len(filter.Includes) > 0 {
+		var listDiags diag.Diagnostics
+		model.Include, listDiags = types.ListValueFrom(ctx, types.StringType, filter.Includes)
+		diags.Append(listDiags...)
+	}
+	if len(filter.Excludes) > 0 {
+		var listDiags diag.Diagnostics
+		model.Exclude, listDiags = types.ListValueFrom(ctx, types.StringType, filter.Excludes)
+		diags.Append(listDiags...)
+	}
+
+	value, objectDiags := types.ObjectValueFrom(ctx, attributeTypes, model)
+	diags.Append(objectDiags...)
+	return value, diags
+}
+
+var (
+	_ basetypes.StringTypable                    = pathPrefixType{}
+	_ basetypes.StringValuableWithSemanticEquals = pathPrefixValue{}
+)
+
+// pathPrefixType is the type of `path_prefix`. Its values are equal when they hold the same JSON document, so a
+// path prefix reformatted by the API does not show up as a change.
+type pathPrefixType struct {
+	basetypes.StringType
+}
+
+func (t pathPrefixType) Equal(o attr.Type) bool {
+	other, ok := o.(pathPrefixType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t pathPrefixType) String() string {
+	return "pathPrefixType"
+}
+
+func (t pathPrefixType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return pathPrefixValue{StringValue: in}, nil
+}
+
+func (t pathPrefixType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return pathPrefixValue{StringValue: stringValue}, nil
+}
+
+func (t pathPrefixType) ValueType(_ context.Context) attr.Value {
+	return pathPrefixValue{}
+}
+
+type pathPrefixValue struct {
+	basetypes.StringValue
+}
+
+func newPathPrefixValue(value string) pathPrefixValue {
+	return pathPrefixValue{StringValue: types.StringValue(value)}
+}
+
+func (v pathPrefixValue) Equal(o attr.Value) bool {
+	other, ok := o.(pathPrefixValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v pathPrefixValue) Type(_ context.Context) attr.Type {
+	return pathPrefixType{}
+}
+
+func (v pathPrefixValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(pathPrefixValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T, got %T", v, newValuable))
+		return false, diags
+	}
+	return jsonSemanticallyEqual(v.ValueString(), newValue.ValueString()), diags
+}
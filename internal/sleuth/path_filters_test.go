@@ -0,0 +1,133 @@
+package sleuth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONSemanticallyEqual(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: `{"excludes":[],"includes":["a/*"]}`, b: `{ "includes": ["a/*"], "excludes": [] }`, expected: true},
+		{a: `{"excludes":[],"includes":["a/*"]}`, b: `{"includes":["a/*"]}`, expected: false},
+		{a: `{"includes":["a/*","b"]}`, b: `{"includes":["b","a/*"]}`, expected: false},
+		{a: "", b: "", expected: true},
+		{a: "services/payments", b: "services/payments", expected: true},
+		{a: "services/payments", b: `"services/payments"`, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if equal := jsonSemanticallyEqual(tt.a, tt.b); equal != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, equal)
+			}
+		})
+	}
+}
+
+func TestValidatePathGlob(t *testing.T) {
+	tests := []struct {
+		glob string
+		err  bool
+	}{
+		{glob: "services/api/*"},
+		{glob: "libs/**/*.go"},
+		{glob: "services/[ab]pi"},
+		{glob: "", err: true},
+		{glob: "services/[api", err: true},
+		{glob: "x\\", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.glob, func(t *testing.T) {
+			err := validatePathGlob(tt.glob)
+			if tt.err != (err != nil) {
+				t.Errorf("expected error %t, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestGetPathFiltersValue(t *testing.T) {
+	ctx := context.Background()
+	attributeTypes := pathFiltersResourceModel{}.AttributeTypes()
+	stringList := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+	pathFilters := func(include, exclude types.List) types.Object {
+		return types.ObjectValueMust(attributeTypes, map[string]attr.Value{"include": include, "exclude": exclude})
+	}
+	nullList := types.ListNull(types.StringType)
+
+	tests := []struct {
+		name       string
+		pathPrefix string
+		prior      types.Object
+		expected   types.Object
+	}{
+		{
+			name:       "empty path prefix",
+			pathPrefix: "",
+			prior:      types.ObjectNull(attributeTypes),
+			expected:   types.ObjectNull(attributeTypes),
+		},
+		{
+			name:       "plain path prefix",
+			pathPrefix: "services/payments",
+			prior:      types.ObjectNull(attributeTypes),
+			expected:   types.ObjectNull(attributeTypes),
+		},
+		{
+			name:       "unknown keys",
+			pathPrefix: `{"includes":["a"],"paths":["b"]}`,
+			prior:      types.ObjectNull(attributeTypes),
+			expected:   types.ObjectNull(attributeTypes),
+		},
+		{
+			name:       "empty lists are null",
+			pathPrefix: `{"excludes":[],"includes":["services/*"]}`,
+			prior:      types.ObjectNull(attributeTypes),
+			expected:   pathFilters(stringList("services/*"), nullList),
+		},
+		{
+			name:       "missing keys are null",
+			pathPrefix: `{"excludes":["docs/*"]}`,
+			prior:      types.ObjectUnknown(attributeTypes),
+			expected:   pathFilters(nullList, stringList("docs/*")),
+		},
+		{
+			name:       "prior empty list kept",
+			pathPrefix: `{"includes":["services/*"],"excludes":[]}`,
+			prior:      pathFilters(stringList("services/*"), stringList()),
+			expected:   pathFilters(stringList("services/*"), stringList()),
+		},
+		{
+			name:       "changed prior replaced",
+			pathPrefix: `{"excludes":[],"includes":["services/*","libs/*"]}`,
+			prior:      pathFilters(stringList("services/*"), stringList()),
+			expected:   pathFilters(stringList("services/*", "libs/*"), nullList),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, diags := getPathFiltersValue(ctx, tt.pathPrefix, tt.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if diff := cmp.Diff(tt.expected, value); diff != "" {
+				t.Errorf("unexpected path filters (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &projectDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectDataSource{}
+)
+
+type projectDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+func (p *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Description: "Project data source looks up an existing Sleuth project by its slug.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Project slug",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Project name",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Project description",
+				Computed:            true,
+			},
+			"issue_tracker_provider_type": schema.StringAttribute{
+				MarkdownDescription: "Where to find issues linked to by changes",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"build_provider": schema.StringAttribute{
+				MarkdownDescription: "Where to find builds related to changes",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"change_failure_rate_boundary": schema.StringAttribute{
+				MarkdownDescription: "The health rating at which point it will be considered a failure",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"impact_sensitivity": schema.StringAttribute{
+				MarkdownDescription: "How many impact measures Sleuth takes into account when auto-determining a deploys health.",
+				CustomType:          caseInsensitiveStringType{},
+				Computed:            true,
+			},
+			"failure_sensitivity": schema.Int64Attribute{
+				MarkdownDescription: "The amount of time (in seconds) a deploy must spend in a failure status (Unhealthy, Incident, etc.) before it is determined a failure.",
+				Computed:            true,
+			},
+			"change_lead_time_start_definition": schema.StringAttribute{
+				Description: "The event that will be taken as a start definition (first commit, issue transition or whichever comes first).",
+				CustomType:  caseInsensitiveStringType{},
+				Computed:    true,
+			},
+			"change_lead_time_issue_states": schema.SetAttribute{
+				Description: "Issue state IDs used for start definition.",
+				ElementType: basetypes.Int64Type{},
+				Computed:    true,
+			},
+			"change_lead_time_strict_matching": schema.BoolAttribute{
+				Description: "Whether Sleuth only looks for issue references in PR titles and PR branch names.",
+				Computed:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "Labels are used to categorize projects.",
+				ElementType: basetypes.StringType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (p *projectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (p *projectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (p *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "project")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config projectResourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading Project data source", map[string]any{"slug": config.Slug.ValueString()})
+
+	proj, err := p.c.GetProject(ctx, config.Slug.ValueStringPointer())
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError(
+			"Project not found",
+			fmt.Sprintf("Could not find Project with slug %s", config.Slug.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error obtaining project: %+v", err))
+		res.Diagnostics.AddError(
+			"Error Reading Project",
+			fmt.Sprintf("Could not read Project Slug %s, %+v", config.Slug.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state, diags := getNewStateFromProject(ctx, proj)
+	res.Diagnostics.Append(diags...)
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,50 @@
+package sleuth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccProjectDataSource_v6(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random name so slugs don't collide
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	name := fmt.Sprintf("Terraform test project %s", randomStr)
+	slug := fmt.Sprintf("terraform-test-project-%s", randomStr)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_project.terraform_acc_test", "slug", slug),
+					resource.TestCheckResourceAttr("data.sleuth_project.terraform_acc_test", "name", name),
+					resource.TestCheckResourceAttr("data.sleuth_project.terraform_acc_test", "build_provider", "GITHUB"),
+					resource.TestCheckResourceAttr("data.sleuth_project.terraform_acc_test", "impact_sensitivity", "FINE"),
+					resource.TestCheckResourceAttr("data.sleuth_project.terraform_acc_test", "failure_sensitivity", "200"),
+				),
+			},
+		},
+	})
+}
+
+func projectDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+  name = "%s"
+  build_provider = "GITHUB"
+  impact_sensitivity = "FINE"
+  failure_sensitivity = 200
+}
+
+data "sleuth_project" "terraform_acc_test" {
+  slug = sleuth_project.terraform_acc_test.slug
+}
+`, name)
+}
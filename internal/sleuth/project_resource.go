@@ -2,6 +2,7 @@ package sleuth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -15,11 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/validators"
 )
 
 var (
@@ -28,20 +31,25 @@ var (
 	_ resource.ResourceWithImportState = &projectResource{}
 )
 
+var (
+	issueTrackerProviders = []string{"SOURCE_PROVIDER", "JIRA", "LINEAR", "CLUBHOUSE", "AZURE"}
+	projectBuildProviders = append([]string{"NONE"}, buildProviders...)
+)
+
 type projectResourceModel struct {
-	ID                            types.String `tfsdk:"id"`
-	Name                          types.String `tfsdk:"name"`
-	Slug                          types.String `tfsdk:"slug"`
-	Description                   types.String `tfsdk:"description"`
-	IssueTrackerProviderType      types.String `tfsdk:"issue_tracker_provider_type"`
-	BuildProvider                 types.String `tfsdk:"build_provider"`
-	ChangeFailureRateBoundary     types.String `tfsdk:"change_failure_rate_boundary"`
-	ImpactSensitivity             types.String `tfsdk:"impact_sensitivity"`
-	FailureSensitivity            types.Int64  `tfsdk:"failure_sensitivity"`
-	ChangeLeadTimeStartDefinition types.String `tfsdk:"change_lead_time_start_definition"`
-	ChangeLeadTimeIssueStates     types.Set    `tfsdk:"change_lead_time_issue_states"`
-	ChangeLeadTimeStrictMatching  types.Bool   `tfsdk:"change_lead_time_strict_matching"`
-	Labels                        types.List   `tfsdk:"labels"`
+	ID                            types.String               `tfsdk:"id"`
+	Name                          types.String               `tfsdk:"name"`
+	Slug                          types.String               `tfsdk:"slug"`
+	Description                   types.String               `tfsdk:"description"`
+	IssueTrackerProviderType      caseInsensitiveStringValue `tfsdk:"issue_tracker_provider_type"`
+	BuildProvider                 caseInsensitiveStringValue `tfsdk:"build_provider"`
+	ChangeFailureRateBoundary     caseInsensitiveStringValue `tfsdk:"change_failure_rate_boundary"`
+	ImpactSensitivity             caseInsensitiveStringValue `tfsdk:"impact_sensitivity"`
+	FailureSensitivity            types.Int64                `tfsdk:"failure_sensitivity"`
+	ChangeLeadTimeStartDefinition caseInsensitiveStringValue `tfsdk:"change_lead_time_start_definition"`
+	ChangeLeadTimeIssueStates     types.Set                  `tfsdk:"change_lead_time_issue_states"`
+	ChangeLeadTimeStrictMatching  types.Bool                 `tfsdk:"change_lead_time_strict_matching"`
+	Labels                        types.List                 `tfsdk:"labels"`
 }
 
 type projectResource struct {
@@ -79,28 +87,44 @@ func (p *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Default:             stringdefault.StaticString(""),
 			},
 			"issue_tracker_provider_type": schema.StringAttribute{
-				MarkdownDescription: "Where to find issues linked to by changes",
+				MarkdownDescription: "Where to find issues linked to by changes - options: SOURCE_PROVIDER (default), JIRA, LINEAR, CLUBHOUSE, AZURE.",
+				CustomType:          caseInsensitiveStringType{},
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("SOURCE_PROVIDER"),
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive(issueTrackerProviders...),
+				},
 			},
 			"build_provider": schema.StringAttribute{
-				MarkdownDescription: "Where to find builds related to changes",
+				MarkdownDescription: "Where to find builds related to changes - options: NONE (default), AZURE, BITBUCKET_PIPELINES, BUILDKITE, CIRCLECI, GITHUB, GITLAB, JENKINS.",
+				CustomType:          caseInsensitiveStringType{},
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("NONE"),
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive(projectBuildProviders...),
+				},
 			},
 			"change_failure_rate_boundary": schema.StringAttribute{
-				MarkdownDescription: "The health rating at which point it will be considered a failure",
+				MarkdownDescription: "The health rating at which point it will be considered a failure - options: AILING, UNHEALTHY (default), INCIDENT.",
+				CustomType:          caseInsensitiveStringType{},
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("UNHEALTHY"),
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive("AILING", "UNHEALTHY", "INCIDENT"),
+				},
 			},
 			"impact_sensitivity": schema.StringAttribute{
-				MarkdownDescription: "How many impact measures Sleuth takes into account when auto-determining a deploys health.",
+				MarkdownDescription: "How many impact measures Sleuth takes into account when auto-determining a deploys health - options: VERY_FINE, FINE, NORMAL (default), COARSE, VERY_COARSE.",
+				CustomType:          caseInsensitiveStringType{},
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("NORMAL"),
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive("VERY_FINE", "FINE", "NORMAL", "COARSE", "VERY_COARSE"),
+				},
 			},
 			"failure_sensitivity": schema.Int64Attribute{
 				MarkdownDescription: "The amount of time (in seconds) a deploy must spend in a failure status (Unhealthy, Incident, etc.) before it is determined a failure. Setting this value to a longer time means that less deploys will be classified.",
@@ -110,9 +134,13 @@ func (p *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"change_lead_time_start_definition": schema.StringAttribute{
 				Description: "The event that will be taken as a start definition (first commit, issue transition or whichever comes first) - options: COMMIT (default), ISSUE, FIRST_EVENT.",
+				CustomType:  caseInsensitiveStringType{},
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("COMMIT"),
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive("COMMIT", "ISSUE", "FIRST_EVENT"),
+				},
 			},
 			"change_lead_time_issue_states": schema.SetAttribute{
 				Description: "Issue state IDs used for start definition (only used if change_lead_time_start_definition is ISSUE or FIRST_EVENT.",
@@ -165,10 +193,7 @@ func (p *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	proj, err := p.c.CreateProject(ctx, input)
 	if err != nil {
 		tflog.Error(ctx, "Error creating Project", map[string]any{"error": err.Error()})
-		res.Diagnostics.AddError(
-			"Error creating Project",
-			fmt.Sprintf("Could not create project, unexpected error: %+v", err.Error()),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating Project", fmt.Sprintf("Could not create project, unexpected error: %+v", err.Error()), err, projectFieldAliases)
 		return
 	}
 
@@ -196,6 +221,11 @@ func (p *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	proj, err := p.c.GetProject(ctx, state.Slug.ValueStringPointer())
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		tflog.Warn(ctx, "Project not found, removing it from state")
+		res.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error obtaining project: %+v", err))
 		res.Diagnostics.AddError(
@@ -204,10 +234,6 @@ func (p *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 		return
 	}
-	if proj == nil {
-		return
-	}
-
 	newState, diags := getNewStateFromProject(ctx, proj)
 	res.Diagnostics.Append(diags...)
 
@@ -234,9 +260,9 @@ func (p *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	input := gqlclient.UpdateProjectMutationInput{Slug: state.Slug.ValueString(), MutableProject: &inputFields}
 
 	proj, err := p.c.UpdateProject(ctx, state.Slug.ValueStringPointer(), input)
-	tflog.Error(ctx, fmt.Sprintf("PRoj: %+v %+v", proj, err))
 	if err != nil {
-		diags.AddError("Error updating project", err.Error())
+		tflog.Error(ctx, "Error updating Project", map[string]any{"error": err.Error()})
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error updating project", err.Error(), err, projectFieldAliases)
 		return
 	}
 
@@ -300,12 +326,12 @@ func getNewStateFromProject(ctx context.Context, proj *gqlclient.Project) (proje
 		Name:                          types.StringValue(proj.Name),
 		Slug:                          types.StringValue(proj.Slug),
 		Description:                   types.StringValue(proj.Description),
-		IssueTrackerProviderType:      types.StringValue(proj.IssueTrackerProvider),
-		BuildProvider:                 types.StringValue(proj.BuildProvider),
-		ChangeFailureRateBoundary:     types.StringValue(proj.ChangeFailureRateBoundary),
-		ImpactSensitivity:             types.StringValue(proj.ImpactSensitivity),
+		IssueTrackerProviderType:      newCaseInsensitiveStringValue(proj.IssueTrackerProvider),
+		BuildProvider:                 newCaseInsensitiveStringValue(proj.BuildProvider),
+		ChangeFailureRateBoundary:     newCaseInsensitiveStringValue(proj.ChangeFailureRateBoundary),
+		ImpactSensitivity:             newCaseInsensitiveStringValue(proj.ImpactSensitivity),
 		FailureSensitivity:            types.Int64Value(int64(proj.FailureSensitivity)),
-		ChangeLeadTimeStartDefinition: types.StringValue(proj.CltStartDefinition),
+		ChangeLeadTimeStartDefinition: newCaseInsensitiveStringValue(proj.CltStartDefinition),
 		ChangeLeadTimeIssueStates:     types.SetNull(types.Int64Type),
 		ChangeLeadTimeStrictMatching:  types.BoolValue(proj.StrictIssueMatching),
 		Labels:                        labelsValue,
@@ -326,12 +352,12 @@ func getMutableProjectStruct(ctx context.Context, plan projectResourceModel) gql
 	return gqlclient.MutableProject{
 		Name:                      plan.Name.ValueString(),
 		Description:               plan.Description.ValueString(),
-		IssueTrackerProvider:      plan.IssueTrackerProviderType.ValueString(),
-		BuildProvider:             plan.BuildProvider.ValueString(),
-		ChangeFailureRateBoundary: plan.ChangeFailureRateBoundary.ValueString(),
-		ImpactSensitivity:         plan.ImpactSensitivity.ValueString(),
+		IssueTrackerProvider:      plan.IssueTrackerProviderType.upper(),
+		BuildProvider:             plan.BuildProvider.upper(),
+		ChangeFailureRateBoundary: plan.ChangeFailureRateBoundary.upper(),
+		ImpactSensitivity:         plan.ImpactSensitivity.upper(),
 		FailureSensitivity:        int(plan.FailureSensitivity.ValueInt64()),
-		CltStartDefinition:        plan.ChangeLeadTimeStartDefinition.ValueString(),
+		CltStartDefinition:        plan.ChangeLeadTimeStartDefinition.upper(),
 		CltStartStates:            cltStartStates,
 		StrictIssueMatching:       plan.ChangeLeadTimeStrictMatching.ValueBool(),
 		Labels:                    labels,
@@ -2,6 +2,7 @@ package sleuth
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -22,6 +23,24 @@ func TestAccProjectResource_v6(t *testing.T) {
 			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
 		},
 		Steps: []resource.TestStep{
+			// enum-like attributes are validated at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+  name = "%s"
+  impact_sensitivity = "finest"
+  change_lead_time_start_definition = "FIRST_COMMIT"
+}`, name),
+				ExpectError: regexp.MustCompile(`(?s)impact_sensitivity value must be one of:.*"VERY_COARSE",\s+in\s+any\s+case,\s+got:\s+"finest".*change_lead_time_start_definition value must be one of:.*"FIRST_EVENT",\s+in\s+any\s+case,\s+got:\s+"FIRST_COMMIT"`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+  name = "%s"
+  build_provider = "TRAVIS"
+}`, name),
+				ExpectError: regexp.MustCompile(`(?s)build_provider value must be one of:.*"JENKINS",\s+in\s+any\s+case,\s+got:\s+"TRAVIS"`),
+			},
 			// Create and Read testing
 			{
 				Config: createConfig(name),
@@ -36,15 +55,15 @@ func TestAccProjectResource_v6(t *testing.T) {
 					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "failure_sensitivity", "420"),
 				),
 			},
-			// Update testing
+			// Update testing, enum values are case-insensitive so the lowercase ones in the config do not show a diff
 			{
 				Config: updateConfig(updatedName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "name", updatedName),
 					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "slug", slug),
 					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "issue_tracker_provider_type", "SOURCE_PROVIDER"),
-					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "build_provider", "GITHUB"),
-					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "change_failure_rate_boundary", "INCIDENT"),
+					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "build_provider", "github"),
+					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "change_failure_rate_boundary", "incident"),
 					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "impact_sensitivity", "FINE"),
 					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "failure_sensitivity", "200"),
 				),
@@ -66,8 +85,8 @@ func updateConfig(name string) string {
 	return fmt.Sprintf(`
 resource "sleuth_project" "terraform_acc_test" {
   name = "%s"
-  build_provider = "GITHUB"
-  change_failure_rate_boundary = "INCIDENT"
+  build_provider = "github"
+  change_failure_rate_boundary = "incident"
   impact_sensitivity = "FINE"
   failure_sensitivity = 200
 }`, name)
@@ -0,0 +1,238 @@
+package sleuth
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &projectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectsDataSource{}
+)
+
+type projectsDataSourceModel struct {
+	ID         types.String           `tfsdk:"id"`
+	Labels     types.Set              `tfsdk:"labels"`
+	NameRegex  types.String           `tfsdk:"name_regex"`
+	SlugPrefix types.String           `tfsdk:"slug_prefix"`
+	Slugs      types.List             `tfsdk:"slugs"`
+	Projects   []projectResourceModel `tfsdk:"projects"`
+}
+
+type projectsDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+func (p *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		MarkdownDescription: "Lists the projects of the organization, optionally filtered by labels, name and slug. All filters are combined, so a project has to match every one of them to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "Only return projects that have all of these labels",
+				ElementType:         basetypes.StringType{},
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return projects whose name matches this [RE2](https://github.com/google/re2/wiki/Syntax) regular expression",
+				Optional:            true,
+			},
+			"slug_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return projects whose slug starts with this prefix",
+				Optional:            true,
+			},
+			"slugs": schema.ListAttribute{
+				MarkdownDescription: "Slugs of the matching projects, handy for `for_each = toset(...)`",
+				ElementType:         basetypes.StringType{},
+				Computed:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching projects",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "Project slug",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Project name",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Project description",
+							Computed:            true,
+						},
+						"issue_tracker_provider_type": schema.StringAttribute{
+							MarkdownDescription: "Where to find issues linked to by changes",
+							CustomType:          caseInsensitiveStringType{},
+							Computed:            true,
+						},
+						"build_provider": schema.StringAttribute{
+							MarkdownDescription: "Where to find builds related to changes",
+							CustomType:          caseInsensitiveStringType{},
+							Computed:            true,
+						},
+						"change_failure_rate_boundary": schema.StringAttribute{
+							MarkdownDescription: "The health rating at which point it will be considered a failure",
+							CustomType:          caseInsensitiveStringType{},
+							Computed:            true,
+						},
+						"impact_sensitivity": schema.StringAttribute{
+							MarkdownDescription: "How many impact measures Sleuth takes into account when auto-determining a deploys health.",
+							CustomType:          caseInsensitiveStringType{},
+							Computed:            true,
+						},
+						"failure_sensitivity": schema.Int64Attribute{
+							MarkdownDescription: "The amount of time (in seconds) a deploy must spend in a failure status before it is determined a failure.",
+							Computed:            true,
+						},
+						"change_lead_time_start_definition": schema.StringAttribute{
+							Description: "The event that will be taken as a start definition.",
+							CustomType:  caseInsensitiveStringType{},
+							Computed:    true,
+						},
+						"change_lead_time_issue_states": schema.SetAttribute{
+							Description: "Issue state IDs used for start definition.",
+							ElementType: basetypes.Int64Type{},
+							Computed:    true,
+						},
+						"change_lead_time_strict_matching": schema.BoolAttribute{
+							Description: "Whether Sleuth only looks for issue references in PR titles and PR branch names.",
+							Computed:    true,
+						},
+						"labels": schema.ListAttribute{
+							Description: "Labels are used to categorize projects.",
+							ElementType: basetypes.StringType{},
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (p *projectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (p *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "projects")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config projectsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	var labels []string
+	res.Diagnostics.Append(config.Labels.ElementsAs(ctx, &labels, false)...)
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		}
+	}
+
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := p.c.GetProjects(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error obtaining projects: %+v", err))
+		res.Diagnostics.AddError("Error Reading Projects", fmt.Sprintf("Could not list projects, %+v", err.Error()))
+		return
+	}
+
+	matching := filterProjects(projects, labels, nameRegex, config.SlugPrefix.ValueString())
+	tflog.Info(ctx, "Read Projects data source", map[string]any{"total": len(projects), "matching": len(matching)})
+
+	state := projectsDataSourceModel{
+		ID:         types.StringValue("projects"),
+		Labels:     config.Labels,
+		NameRegex:  config.NameRegex,
+		SlugPrefix: config.SlugPrefix,
+		Projects:   []projectResourceModel{},
+	}
+	slugs := []attr.Value{}
+	for _, proj := range matching {
+		prm, diags := getNewStateFromProject(ctx, &proj)
+		res.Diagnostics.Append(diags...)
+		state.Projects = append(state.Projects, prm)
+		slugs = append(slugs, types.StringValue(proj.Slug))
+	}
+	state.Slugs, diags = types.ListValue(basetypes.StringType{}, slugs)
+	res.Diagnostics.Append(diags...)
+
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+}
+
+// filterProjects returns the projects having all labels, whose name matches nameRegex (if given) and whose slug
+// starts with slugPrefix
+func filterProjects(projects []gqlclient.Project, labels []string, nameRegex *regexp.Regexp, slugPrefix string) []gqlclient.Project {
+	var matching []gqlclient.Project
+	for _, proj := range projects {
+		if !strings.HasPrefix(proj.Slug, slugPrefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(proj.Name) {
+			continue
+		}
+		if !hasAllLabels(proj.LabelNames, labels) {
+			continue
+		}
+		matching = append(matching, proj)
+	}
+	return matching
+}
+
+func hasAllLabels(projectLabels []string, labels []string) bool {
+	present := make(map[string]struct{}, len(projectLabels))
+	for _, label := range projectLabels {
+		present[label] = struct{}{}
+	}
+	for _, label := range labels {
+		if _, found := present[label]; !found {
+			return false
+		}
+	}
+	return true
+}
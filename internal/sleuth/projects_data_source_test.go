@@ -0,0 +1,92 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+func TestAccProjectsDataSource_v6(t *testing.T) {
+	// tests are run in parallel both locally & on CI, so we need to generate a random name so slugs don't collide
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	name := fmt.Sprintf("Terraform test project %s", randomStr)
+	slug := fmt.Sprintf("terraform-test-project-%s", randomStr)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectsDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_projects.by_slug", "slugs.#", "1"),
+					resource.TestCheckResourceAttr("data.sleuth_projects.by_slug", "slugs.0", slug),
+					resource.TestCheckResourceAttr("data.sleuth_projects.by_slug", "projects.0.name", name),
+					resource.TestCheckResourceAttr("data.sleuth_projects.by_slug", "projects.0.build_provider", "GITHUB"),
+					resource.TestCheckResourceAttr("data.sleuth_projects.by_name", "slugs.#", "1"),
+					resource.TestCheckResourceAttr("data.sleuth_projects.by_name", "projects.0.slug", slug),
+				),
+			},
+		},
+	})
+}
+
+func projectsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+  name = "%s"
+  build_provider = "GITHUB"
+}
+
+data "sleuth_projects" "by_slug" {
+  slug_prefix = sleuth_project.terraform_acc_test.slug
+}
+
+data "sleuth_projects" "by_name" {
+  name_regex = "^${sleuth_project.terraform_acc_test.name}$"
+}
+`, name)
+}
+
+func TestFilterProjects(t *testing.T) {
+	projects := []gqlclient.Project{
+		{Slug: "payments-api", Name: "Payments API", LabelNames: []string{"tier-1", "backend"}},
+		{Slug: "payments-web", Name: "Payments Web", LabelNames: []string{"tier-2", "frontend"}},
+		{Slug: "search", Name: "Search", LabelNames: []string{"tier-1"}},
+	}
+
+	tests := []struct {
+		name       string
+		labels     []string
+		nameRegex  *regexp.Regexp
+		slugPrefix string
+		expected   []string
+	}{
+		{name: "no filters", expected: []string{"payments-api", "payments-web", "search"}},
+		{name: "single label", labels: []string{"tier-1"}, expected: []string{"payments-api", "search"}},
+		{name: "all labels required", labels: []string{"tier-1", "backend"}, expected: []string{"payments-api"}},
+		{name: "unknown label", labels: []string{"tier-3"}, expected: nil},
+		{name: "name regex", nameRegex: regexp.MustCompile("Web$"), expected: []string{"payments-web"}},
+		{name: "slug prefix", slugPrefix: "payments-", expected: []string{"payments-api", "payments-web"}},
+		{name: "combined", labels: []string{"tier-1"}, slugPrefix: "payments-", expected: []string{"payments-api"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var slugs []string
+			for _, proj := range filterProjects(projects, tt.labels, tt.nameRegex, tt.slugPrefix) {
+				slugs = append(slugs, proj.Slug)
+			}
+			if fmt.Sprint(slugs) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, slugs)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,7 +20,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &sleuthProvider{}
+	_ provider.Provider              = &sleuthProvider{}
+	_ provider.ProviderWithFunctions = &sleuthProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -34,9 +36,13 @@ type sleuthProvider struct {
 
 // sleuthProviderModel maps provider schema data to a Go type.
 type sleuthProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	BaseURL types.String `tfsdk:"baseurl"`
-	Timeout types.Int32  `tfsdk:"timeout"`
+	APIKey                   types.String `tfsdk:"api_key"`
+	BaseURL                  types.String `tfsdk:"baseurl"`
+	Timeout                  types.Int32  `tfsdk:"timeout"`
+	OrgSlug                  types.String `tfsdk:"org_slug"`
+	MaxRetries               types.Int32  `tfsdk:"max_retries"`
+	RetryMaxWait             types.Int32  `tfsdk:"retry_max_wait"`
+	ValidateReferencesOnPlan types.Bool   `tfsdk:"validate_references_on_plan"`
 }
 
 // Metadata returns the provider type name.
@@ -59,7 +65,23 @@ func (p *sleuthProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:            true,
 			},
 			"timeout": schema.Int32Attribute{
-				MarkdownDescription: "Timeout in seconds of Sleuth API responses",
+				MarkdownDescription: "Timeout in seconds of each attempt of a Sleuth API request. A retried request gets a new timeout per attempt, so it can take up to `retry_max_wait` plus `timeout`. Defaults to 20.",
+				Optional:            true,
+			},
+			"org_slug": schema.StringAttribute{
+				MarkdownDescription: "The Sleuth organization's slug. Can also be set with the `SLEUTH_ORG_SLUG` environment variable. If omitted, it is discovered from the API key.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int32Attribute{
+				MarkdownDescription: fmt.Sprintf("How many times a request failing with a rate limit, gateway or network error is retried. Mutations are only retried when rate limited. Defaults to %d.", gqlclient.DefaultMaxRetries),
+				Optional:            true,
+			},
+			"retry_max_wait": schema.Int32Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum total time in seconds spent retrying a request, counting both the attempts and the waits between them from the start of the first attempt. No retry is started once its wait would end later. Defaults to %d.", int(gqlclient.DefaultRetryMaxWait.Seconds())),
+				Optional:            true,
+			},
+			"validate_references_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "Whether the projects, environments, code change sources, integrations and parent teams referenced by metric and error impact sources, build mappings and teams are looked up while planning, so a missing one fails the plan instead of the apply. Defaults to false.",
 				Optional:            true,
 			},
 		},
@@ -71,6 +93,7 @@ func (p *sleuthProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	apiKeyFallback := os.Getenv("SLEUTH_API_KEY")
 	baseURLENVFallback := os.Getenv("SLEUTH_BASEURL")
+	orgSlugFallback := os.Getenv("SLEUTH_ORG_SLUG")
 
 	// Retrieve provider data from configuration
 	var config sleuthProviderModel
@@ -116,9 +139,35 @@ func (p *sleuthProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		timeout = types.Int32Value(20)
 	}
 
+	retry := gqlclient.RetryPolicy{
+		MaxRetries: gqlclient.DefaultMaxRetries,
+		MaxWait:    gqlclient.DefaultRetryMaxWait,
+	}
+	if !config.MaxRetries.IsNull() {
+		if config.MaxRetries.ValueInt32() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid max_retries", "max_retries must not be negative")
+		}
+		retry.MaxRetries = int(config.MaxRetries.ValueInt32())
+	}
+	if !config.RetryMaxWait.IsNull() {
+		if config.RetryMaxWait.ValueInt32() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "Invalid retry_max_wait", "retry_max_wait must not be negative")
+		}
+		retry.MaxWait = time.Duration(config.RetryMaxWait.ValueInt32()) * time.Second
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgSlug := config.OrgSlug.ValueString()
+	if orgSlug == "" {
+		orgSlug = orgSlugFallback
+	}
+
 	ctx = tflog.SetField(ctx, "sleuth_base_url", baseURL)
 	ua := userAgent(req.TerraformVersion, "terraform-provider-sleuth", p.v)
-	c, err := gqlclient.NewClient(baseURL.ValueStringPointer(), apiKey.ValueStringPointer(), ua, time.Duration(timeout.ValueInt32())*time.Second)
+	c, err := gqlclient.NewClient(baseURL.ValueStringPointer(), apiKey.ValueStringPointer(), orgSlug, ua, time.Duration(timeout.ValueInt32())*time.Second, retry)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating new client",
@@ -127,6 +176,21 @@ func (p *sleuthProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	if c.OrgSlug == "" {
+		org, err := c.GetOrganization(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("org_slug"),
+				"Could not discover organization slug",
+				fmt.Sprintf("Could not discover the organization slug from the API key, set `org_slug` or `SLEUTH_ORG_SLUG` explicitly: %+v", err),
+			)
+			return
+		}
+		c.OrgSlug = org.Slug
+	}
+	ctx = tflog.SetField(ctx, "sleuth_org_slug", c.OrgSlug)
+	c.ValidateReferencesOnPlan = config.ValidateReferencesOnPlan.ValueBool()
+
 	// Make the client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = c
@@ -137,7 +201,18 @@ func (p *sleuthProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 // DataSources defines the data sources implemented in the provider.
 func (p *sleuthProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewProjectDataSource,
+		NewCodeChangeSourceDataSource,
+		NewEnvironmentDataSource,
+		NewMetricImpactSourceDataSource,
+		NewErrorImpactSourceDataSource,
+		NewIncidentImpactSourceDataSource,
+		NewTeamDataSource,
+		NewProjectsDataSource,
+		NewIntegrationDataSource,
+		NewIntegrationsDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -145,11 +220,31 @@ func (p *sleuthProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewProjectResource,
 		NewCodeChangeSourceResource,
+		NewEnvironmentMappingResource,
+		NewBuildMappingResource,
 		NewEnvironmentResource,
 		NewMetricImpactSourceResource,
 		NewErrorImpactSourceResource,
 		NewIncidentImpactSourceResource,
+		NewPagerDutyImpactSourceResource,
+		NewDataDogIncidentImpactSourceResource,
+		NewJiraImpactSourceResource,
+		NewBlamelessImpactSourceResource,
+		NewStatuspageImpactSourceResource,
+		NewOpsGenieImpactSourceResource,
+		NewFireHydrantImpactSourceResource,
+		NewClubhouseImpactSourceResource,
+		NewRootlyImpactSourceResource,
 		NewTeamResource,
+		NewIntegrationAuthResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *sleuthProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSlugifyFunction,
+		NewPathFilterFunction,
 	}
 }
 
@@ -1,8 +1,16 @@
 package sleuth
 
 import (
+	"fmt"
+	"os"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/mockserver"
 )
 
 var (
@@ -14,3 +22,54 @@ var (
 		"sleuth": providerserver.NewProtocol6WithError(New("test")),
 	}
 )
+
+// testAccMockServer is the fake Sleuth API acceptance tests run against when SLEUTH_API_KEY is not set
+var testAccMockServer *mockserver.Server
+
+// Members of the fake organization used by the team tests
+var testAccMockUsers = []string{"dbrown@sleuth.io", "detkin@sleuth.io"}
+
+// Integrations of the fake organization used by the integration tests, as provider and description label
+var testAccMockIntegrations = [][2]string{{"DATADOG", "prod"}, {"DATADOG", "staging"}, {"PAGERDUTY", ""}}
+
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") == "" || os.Getenv("SLEUTH_API_KEY") != "" {
+		os.Exit(m.Run())
+	}
+
+	testAccMockServer = mockserver.NewServer("terraform-acc-test", "terraform-acc-test")
+	for _, email := range testAccMockUsers {
+		testAccMockServer.AddUser(email)
+	}
+	for _, integration := range testAccMockIntegrations {
+		testAccMockServer.AddIntegration(integration[0], integration[1])
+	}
+	os.Setenv("SLEUTH_BASEURL", testAccMockServer.URL)
+	os.Setenv("SLEUTH_API_KEY", "terraform-acc-test")
+
+	code := m.Run()
+	testAccMockServer.Close()
+	os.Exit(code)
+}
+
+// testAccImportID returns the `project_slug/slug` import ID of a project scoped resource in state
+func testAccImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_slug"], rs.Primary.Attributes["slug"]), nil
+	}
+}
+
+// testAccImportIDByName returns the `project_slug/name:<Name>` import ID of a project scoped resource in state
+func testAccImportIDByName(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		return fmt.Sprintf("%s/name:%s", rs.Primary.Attributes["project_slug"], rs.Primary.Attributes["name"]), nil
+	}
+}
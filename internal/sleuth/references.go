@@ -0,0 +1,96 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// referenceValidator looks up the objects a planned resource references by slug, when `validate_references_on_plan`
+// is set, so a missing one fails the plan on the referencing attribute instead of failing midway through the apply.
+// Slugs that are unknown, such as the slug of an object created by the same apply, are not checked.
+type referenceValidator struct {
+	c     *gqlclient.Client
+	diags *diag.Diagnostics
+}
+
+// newReferenceValidator returns a validator adding its errors to the response, and false when references are not
+// validated: the setting is off, the provider is not configured yet, or the resource is being destroyed.
+func newReferenceValidator(c *gqlclient.Client, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) (referenceValidator, bool) {
+	if c == nil || !c.ValidateReferencesOnPlan || req.Plan.Raw.IsNull() {
+		return referenceValidator{}, false
+	}
+	return referenceValidator{c: c, diags: &res.Diagnostics}, true
+}
+
+// project validates the project slug at attributePath, and returns whether it exists, so references to objects of the
+// project are only looked up in an existing project
+func (v referenceValidator) project(ctx context.Context, attributePath path.Path, slug types.String) bool {
+	if !isReference(slug) {
+		return false
+	}
+	projectSlug := slug.ValueString()
+	_, err := v.c.GetProject(ctx, &projectSlug)
+	return v.check(attributePath, err, "Missing project", fmt.Sprintf("Project %s does not exist", projectSlug))
+}
+
+func (v referenceValidator) environment(ctx context.Context, attributePath path.Path, projectSlug string, slug types.String) {
+	if !isReference(slug) {
+		return
+	}
+	environmentSlug := slug.ValueString()
+	_, err := v.c.GetEnvironment(ctx, &projectSlug, &environmentSlug)
+	v.check(attributePath, err, "Missing environment", fmt.Sprintf("Environment %s does not exist in project %s", environmentSlug, projectSlug))
+}
+
+func (v referenceValidator) codeChangeSource(ctx context.Context, attributePath path.Path, projectSlug string, slug types.String) {
+	if !isReference(slug) {
+		return
+	}
+	sourceSlug := slug.ValueString()
+	_, err := v.c.GetCodeChangeSource(ctx, &projectSlug, &sourceSlug)
+	v.check(attributePath, err, "Missing code change source", fmt.Sprintf("Code change source %s does not exist in project %s", sourceSlug, projectSlug))
+}
+
+func (v referenceValidator) integration(ctx context.Context, attributePath path.Path, slug types.String) {
+	if !isReference(slug) {
+		return
+	}
+	_, err := v.c.GetIntegration(ctx, slug.ValueString())
+	v.check(attributePath, err, "Missing integration",
+		fmt.Sprintf("Integration %s does not exist, the sleuth_integrations data source lists the integrations of the organization", slug.ValueString()))
+}
+
+func (v referenceValidator) team(ctx context.Context, attributePath path.Path, slug types.String) {
+	if !isReference(slug) {
+		return
+	}
+	teamSlug := slug.ValueString()
+	_, err := v.c.GetTeam(ctx, &teamSlug)
+	v.check(attributePath, err, "Missing team", fmt.Sprintf("Team %s does not exist", teamSlug))
+}
+
+// isReference reports whether slug is known and not empty, as the API returns an empty slug for unset references
+func isReference(slug types.String) bool {
+	return isSet(slug) && slug.ValueString() != ""
+}
+
+// check adds an error on attributePath for the error of a lookup, and returns whether the lookup succeeded
+func (v referenceValidator) check(attributePath path.Path, err error, summary, notFoundDetail string) bool {
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		v.diags.AddAttributeError(attributePath, summary, notFoundDetail)
+		return false
+	}
+	if err != nil {
+		v.diags.AddAttributeError(attributePath, "Error validating reference", err.Error())
+		return false
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package sleuth
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccValidateReferencesOnPlan(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	projectName := fmt.Sprintf("Terraform test project %s", randomStr)
+	projectSlug := fmt.Sprintf("terraform-test-project-%s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: referencesConfig(projectName, ""),
+			},
+			{
+				Config: referencesConfig(projectName, fmt.Sprintf(`
+resource "sleuth_metric_impact_source" "terraform_acc_test" {
+	project_slug     = "%s"
+	environment_slug = "qa"
+	name             = "Datadog acceptance test"
+	provider_type    = "DATADOG"
+	query            = "avg(last_5m):avg:system.cpu.user{*} > 0"
+	integration_slug = "datadog-missing"
+}`, projectSlug)),
+				ExpectError: regexp.MustCompile(`(?s)Environment qa does not exist in project\s+` + projectSlug + `.*Integration datadog-missing does not exist`),
+			},
+			{
+				Config: referencesConfig(projectName, `
+resource "sleuth_error_impact_source" "terraform_acc_test" {
+	project_slug      = "missing-project"
+	environment_slug  = "production"
+	name              = "Sentry errors"
+	provider_type     = "SENTRY"
+	error_org_key     = "sleuthio"
+	error_project_key = "sleuth-dev"
+	error_environment = "prod"
+}`),
+				ExpectError: regexp.MustCompile(`Project missing-project does not exist`),
+			},
+			{
+				Config: referencesConfig(projectName, fmt.Sprintf(`
+resource "sleuth_build_mapping" "terraform_acc_test" {
+	project_slug            = "%s"
+	code_change_source_slug = "missing-source"
+	environment_slug        = "production"
+	build_name              = "release"
+	provider_type           = "GITHUB"
+}`, projectSlug)),
+				ExpectError: regexp.MustCompile(`Code change source missing-source does not exist in project`),
+			},
+			{
+				Config: referencesConfig(projectName, `
+resource "sleuth_team" "terraform_acc_test" {
+	name        = "Terraform subteam"
+	parent_slug = "missing-team"
+}`),
+				ExpectError: regexp.MustCompile(`Team missing-team does not exist`),
+			},
+			// existing references pass, the project slug is known from the state
+			{
+				Config: referencesConfig(projectName, `
+resource "sleuth_metric_impact_source" "terraform_acc_test" {
+	project_slug     = sleuth_project.terraform_acc_test.slug
+	environment_slug = "production"
+	name             = "Datadog acceptance test"
+	provider_type    = "DATADOG"
+	query            = "avg(last_5m):avg:system.cpu.user{*} > 0"
+	integration_slug = "datadog-prod"
+}`),
+				Check: resource.TestCheckResourceAttr("sleuth_metric_impact_source.terraform_acc_test", "environment_slug", "production"),
+			},
+		},
+	})
+}
+
+func referencesConfig(projectName, resources string) string {
+	return fmt.Sprintf(`
+provider "sleuth" {
+	validate_references_on_plan = true
+}
+
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+%s
+`, projectName, resources)
+}
@@ -0,0 +1,293 @@
+package sleuth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// repositoryBlock is one of the provider-specific blocks of a code change source `repository`
+type repositoryBlock struct {
+	attribute string
+	provider  string
+	title     string
+	// baseURL is where repositories of hosted providers live, so `url` can be left out
+	baseURL string
+	// azure repositories are also identified by their project and repository UIDs
+	azure bool
+}
+
+var repositoryBlocks = []repositoryBlock{
+	{attribute: "github", provider: "GITHUB", title: "GitHub", baseURL: "https://github.com"},
+	{attribute: "github_enterprise", provider: "GITHUB_ENTERPRISE", title: "GitHub Enterprise Server"},
+	{attribute: "gitlab", provider: "GITLAB", title: "GitLab", baseURL: "https://gitlab.com"},
+	{attribute: "bitbucket", provider: "BITBUCKET", title: "Bitbucket", baseURL: "https://bitbucket.org"},
+	{attribute: "azure", provider: "AZURE", title: "Azure DevOps", azure: true},
+	{attribute: "custom_git", provider: "CUSTOM_GIT", title: "a custom git server"},
+}
+
+// repositoryBlockNames are the attribute names of the provider-specific blocks, for error messages
+var repositoryBlockNames = func() string {
+	var names []string
+	for _, block := range repositoryBlocks {
+		names = append(names, "`"+block.attribute+"`")
+	}
+	return strings.Join(names, ", ")
+}()
+
+// repositoryProviders are the API providers of the provider-specific blocks, the values of the deprecated `provider`
+var repositoryProviders = func() []string {
+	var providers []string
+	for _, block := range repositoryBlocks {
+		providers = append(providers, block.provider)
+	}
+	return providers
+}()
+
+// findRepositoryBlock returns the index in repositoryBlocks of the block for an API provider, in any case
+func findRepositoryBlock(provider string) (int, bool) {
+	for idx, block := range repositoryBlocks {
+		if strings.EqualFold(block.provider, provider) {
+			return idx, true
+		}
+	}
+	return 0, false
+}
+
+func (b repositoryBlock) AttributeTypes() map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{
+		"owner": types.StringType,
+		"name":  types.StringType,
+		"url":   types.StringType,
+	}
+	if b.azure {
+		attributeTypes["project_uid"] = types.StringType
+		attributeTypes["repo_uid"] = types.StringType
+	}
+	return attributeTypes
+}
+
+// defaultURL returns the URL of a repository of a hosted provider, or "" for providers without one
+func (b repositoryBlock) defaultURL(owner, name string) string {
+	if b.baseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", b.baseURL, owner, name)
+}
+
+// blocks returns pointers to the provider-specific blocks of r, in the order of repositoryBlocks
+func (r *repositoryResourceModel) blocks() []*types.Object {
+	return []*types.Object{&r.GitHub, &r.GitHubEnterprise, &r.GitLab, &r.Bitbucket, &r.Azure, &r.CustomGit}
+}
+
+// configuredBlock returns the provider-specific block set in r, if any
+func (r *repositoryResourceModel) configuredBlock() (repositoryBlock, types.Object, bool) {
+	for idx, value := range r.blocks() {
+		if !value.IsNull() {
+			return repositoryBlocks[idx], *value, true
+		}
+	}
+	return repositoryBlock{}, types.Object{}, false
+}
+
+// input returns the repository to send to the API, from the provider-specific block or the deprecated attributes
+func (r *repositoryResourceModel) input() gqlclient.RepositoryBase {
+	block, value, ok := r.configuredBlock()
+	if !ok {
+		return gqlclient.RepositoryBase{
+			Owner:      r.Owner.ValueString(),
+			Name:       r.Name.ValueString(),
+			Provider:   r.Provider.upper(),
+			Url:        r.URL.ValueString(),
+			ProjectUID: r.ProjectUID.ValueString(),
+			RepoUID:    r.RepoUID.ValueString(),
+		}
+	}
+
+	attributes := value.Attributes()
+	stringAttribute := func(name string) string {
+		s, _ := attributes[name].(types.String)
+		return s.ValueString()
+	}
+	repository := gqlclient.RepositoryBase{
+		Owner:      stringAttribute("owner"),
+		Name:       stringAttribute("name"),
+		Provider:   block.provider,
+		Url:        stringAttribute("url"),
+		ProjectUID: stringAttribute("project_uid"),
+		RepoUID:    stringAttribute("repo_uid"),
+	}
+	if repository.Url == "" {
+		repository.Url = block.defaultURL(repository.Owner, repository.Name)
+	}
+	return repository
+}
+
+// getRepositoryBlockValue returns the provider-specific block describing repository. With omitDefaultURL, a URL that
+// is the default one of a hosted provider is left out, as it was not configured.
+func getRepositoryBlockValue(block repositoryBlock, repository gqlclient.RepositoryBase, omitDefaultURL bool) (types.Object, diag.Diagnostics) {
+	url := types.StringValue(repository.Url)
+	if omitDefaultURL && repository.Url == block.defaultURL(repository.Owner, repository.Name) {
+		url = types.StringNull()
+	}
+	attributes := map[string]attr.Value{
+		"owner": types.StringValue(repository.Owner),
+		"name":  types.StringValue(repository.Name),
+		"url":   url,
+	}
+	if block.azure {
+		attributes["project_uid"] = types.StringValue(repository.ProjectUID)
+		attributes["repo_uid"] = types.StringValue(repository.RepoUID)
+	}
+	return types.ObjectValue(block.AttributeTypes(), attributes)
+}
+
+// setRepositoryState sets the repository returned by the API on r. Sources configured with the deprecated attributes
+// keep using them, everything else, including imported sources, is set in the block of the provider.
+func setRepositoryState(r *repositoryResourceModel, repository gqlclient.RepositoryBase, prior *repositoryResourceModel) diag.Diagnostics {
+	for idx, value := range r.blocks() {
+		*value = types.ObjectNull(repositoryBlocks[idx].AttributeTypes())
+	}
+	r.Owner, r.Name, r.URL = types.StringNull(), types.StringNull(), types.StringNull()
+	r.Provider = newCaseInsensitiveStringNull()
+	r.RepoUID, r.ProjectUID = types.StringNull(), types.StringNull()
+
+	idx, ok := findRepositoryBlock(repository.Provider)
+	if !ok || (prior != nil && !prior.Provider.IsNull()) {
+		setDeprecatedRepositoryState(r, repository)
+		return nil
+	}
+
+	omitDefaultURL := true
+	if prior != nil {
+		if _, priorValue, ok := prior.configuredBlock(); ok {
+			if url, isString := priorValue.Attributes()["url"].(types.String); isString && !url.IsNull() {
+				omitDefaultURL = false
+			}
+		}
+	}
+
+	var diags diag.Diagnostics
+	*r.blocks()[idx], diags = getRepositoryBlockValue(repositoryBlocks[idx], repository, omitDefaultURL)
+	return diags
+}
+
+// setDeprecatedRepositoryState sets the repository returned by the API on the deprecated attributes of r
+func setDeprecatedRepositoryState(r *repositoryResourceModel, repository gqlclient.RepositoryBase) {
+	r.Owner = types.StringValue(repository.Owner)
+	r.Name = types.StringValue(repository.Name)
+	r.URL = types.StringValue(repository.Url)
+	r.Provider = newCaseInsensitiveStringValue(repository.Provider)
+	if strings.EqualFold(repository.Provider, azureProvider) {
+		r.RepoUID = types.StringValue(repository.RepoUID)
+		r.ProjectUID = types.StringValue(repository.ProjectUID)
+	}
+}
+
+// deprecatedRepositoryAttributes are the attributes a repository is configured with when it has no provider block
+var deprecatedRepositoryAttributes = []string{"owner", "name", "url", "provider", "repo_uid", "project_uid"}
+
+// validateRepositoryConfig validates that the repository is configured with exactly one provider-specific block, or
+// with the deprecated attributes, and that everything the provider needs is set
+func validateRepositoryConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var value types.Object
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("repository"), &value)...)
+	if res.Diagnostics.HasError() || value.IsNull() || value.IsUnknown() {
+		return
+	}
+	var repository repositoryResourceModel
+	res.Diagnostics.Append(value.As(ctx, &repository, basetypes.ObjectAsOptions{})...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	repositoryPath := path.Root("repository")
+
+	var configured []repositoryBlock
+	for idx, blockValue := range repository.blocks() {
+		if !blockValue.IsNull() {
+			configured = append(configured, repositoryBlocks[idx])
+		}
+	}
+	deprecated := map[string]types.String{
+		"owner":       repository.Owner,
+		"name":        repository.Name,
+		"url":         repository.URL,
+		"provider":    repository.Provider.StringValue,
+		"repo_uid":    repository.RepoUID,
+		"project_uid": repository.ProjectUID,
+	}
+
+	switch {
+	case len(configured) > 1:
+		res.Diagnostics.AddAttributeError(
+			repositoryPath,
+			"Conflicting repository providers",
+			fmt.Sprintf("Only one of %s can be set, got `%s` and `%s`", repositoryBlockNames, configured[0].attribute, configured[1].attribute),
+		)
+	case len(configured) == 1:
+		block := configured[0]
+		for _, name := range deprecatedRepositoryAttributes {
+			if !deprecated[name].IsNull() {
+				res.Diagnostics.AddAttributeError(
+					repositoryPath.AtName(name),
+					"Conflicting repository attributes",
+					fmt.Sprintf("%s cannot be set together with the `%s` block, which holds the whole repository", name, block.attribute),
+				)
+			}
+		}
+		if block.azure && repository.IntegrationSlug.IsNull() {
+			res.Diagnostics.AddAttributeError(
+				repositoryPath.AtName("integration_slug"),
+				"Missing integration_slug",
+				fmt.Sprintf("integration_slug is required for %s repositories", block.title),
+			)
+		}
+	case repository.Provider.IsNull():
+		res.Diagnostics.AddAttributeError(
+			repositoryPath,
+			"Missing repository provider",
+			fmt.Sprintf("Set one of %s to describe the repository", repositoryBlockNames),
+		)
+	default:
+		validateDeprecatedRepositoryConfig(repository, deprecated, res)
+	}
+}
+
+// validateDeprecatedRepositoryConfig validates a repository configured with the deprecated attributes
+func validateDeprecatedRepositoryConfig(repository repositoryResourceModel, deprecated map[string]types.String, res *resource.ValidateConfigResponse) {
+	repositoryPath := path.Root("repository")
+	required := []string{"owner", "name", "url"}
+	if !repository.Provider.IsUnknown() {
+		idx, ok := findRepositoryBlock(repository.Provider.ValueString())
+		if !ok {
+			// reported by the validator of `provider`
+			return
+		}
+		if repositoryBlocks[idx].azure {
+			required = append(required, "project_uid", "repo_uid")
+			if repository.IntegrationSlug.IsNull() {
+				required = append(required, "integration_slug")
+				deprecated["integration_slug"] = repository.IntegrationSlug
+			}
+		}
+	}
+
+	for _, name := range required {
+		if deprecated[name].IsNull() {
+			res.Diagnostics.AddAttributeError(
+				repositoryPath.AtName(name),
+				"Missing repository attribute",
+				fmt.Sprintf("%s is required for %s repositories", name, repository.Provider.upper()),
+			)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package sleuth
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+func TestRepositoryInput(t *testing.T) {
+	block := func(attribute string, attributes map[string]attr.Value) *repositoryResourceModel {
+		r := withoutRepositoryBlocks(repositoryResourceModel{})
+		idx, _ := findRepositoryBlock(attribute)
+		*r.blocks()[idx] = types.ObjectValueMust(repositoryBlocks[idx].AttributeTypes(), attributes)
+		return r
+	}
+
+	tests := []struct {
+		name       string
+		repository *repositoryResourceModel
+		expected   gqlclient.RepositoryBase
+	}{
+		{
+			name: "deprecated attributes",
+			repository: &repositoryResourceModel{
+				Owner:    types.StringValue("sleuth-io"),
+				Name:     types.StringValue("payments"),
+				URL:      types.StringValue("https://github.com/sleuth-io/payments"),
+				Provider: newCaseInsensitiveStringValue("github"),
+			},
+			expected: gqlclient.RepositoryBase{Owner: "sleuth-io", Name: "payments", Provider: "GITHUB", Url: "https://github.com/sleuth-io/payments"},
+		},
+		{
+			name: "hosted provider defaults the url",
+			repository: block("bitbucket", map[string]attr.Value{
+				"owner": types.StringValue("sleuth"),
+				"name":  types.StringValue("payments"),
+				"url":   types.StringNull(),
+			}),
+			expected: gqlclient.RepositoryBase{Owner: "sleuth", Name: "payments", Provider: "BITBUCKET", Url: "https://bitbucket.org/sleuth/payments"},
+		},
+		{
+			name: "configured url",
+			repository: block("gitlab", map[string]attr.Value{
+				"owner": types.StringValue("sleuth-io"),
+				"name":  types.StringValue("payments"),
+				"url":   types.StringValue("https://gitlab.com/sleuth-io/backend/payments"),
+			}),
+			expected: gqlclient.RepositoryBase{Owner: "sleuth-io", Name: "payments", Provider: "GITLAB", Url: "https://gitlab.com/sleuth-io/backend/payments"},
+		},
+		{
+			name: "azure",
+			repository: block("azure", map[string]attr.Value{
+				"owner":       types.StringValue("sleuth"),
+				"name":        types.StringValue("payments"),
+				"url":         types.StringValue("https://dev.azure.com/sleuth/payments/_git/payments"),
+				"project_uid": types.StringValue("7d3c0f"),
+				"repo_uid":    types.StringValue("b1a2e9"),
+			}),
+			expected: gqlclient.RepositoryBase{
+				Owner:      "sleuth",
+				Name:       "payments",
+				Provider:   "AZURE",
+				Url:        "https://dev.azure.com/sleuth/payments/_git/payments",
+				ProjectUID: "7d3c0f",
+				RepoUID:    "b1a2e9",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, tt.repository.input()); diff != "" {
+				t.Errorf("unexpected input (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package sleuth
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ function.Function = &slugifyFunction{}
+)
+
+type slugifyFunction struct{}
+
+func NewSlugifyFunction() function.Function {
+	return &slugifyFunction{}
+}
+
+func (f *slugifyFunction) Metadata(_ context.Context, _ function.MetadataRequest, res *function.MetadataResponse) {
+	res.Name = "slugify"
+}
+
+func (f *slugifyFunction) Definition(_ context.Context, _ function.DefinitionRequest, res *function.DefinitionResponse) {
+	res.Definition = function.Definition{
+		Summary: "Derives a slug from a name the way Sleuth does",
+		MarkdownDescription: "Returns the slug Sleuth derives from `name` when a project, environment, source or team is created, so it can be used before the resource is applied. " +
+			"Accents are folded to ASCII and other non-ASCII characters dropped, characters other than letters, digits, underscores, hyphens and whitespace are removed, the rest is lowercased and runs of whitespace and hyphens become a single hyphen. " +
+			"Sleuth adds a numeric suffix (`-2`, `-3`, ...) when the slug is already taken within the project or organization, which this function can not know about.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Name to derive the slug from",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *slugifyFunction) Run(ctx context.Context, req function.RunRequest, res *function.RunResponse) {
+	var name string
+	res.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if res.Error != nil {
+		return
+	}
+
+	res.Error = function.ConcatFuncErrors(res.Result.Set(ctx, gqlclient.Slugify(name)))
+}
@@ -0,0 +1,58 @@
+package sleuth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSlugifyFunctionRun(t *testing.T) {
+	res := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	NewSlugifyFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("Payments API (EU)")}),
+	}, &res)
+	if res.Error != nil {
+		t.Fatal(res.Error)
+	}
+	if slug := res.Result.Value(); !slug.Equal(types.StringValue("payments-api-eu")) {
+		t.Errorf("expected payments-api-eu, got %s", slug)
+	}
+}
+
+func TestAccSlugifyFunction(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	name := fmt.Sprintf("Terraform test project (slugify) %s", randomStr)
+	slug := fmt.Sprintf("terraform-test-project-slugify-%s", strings.ToLower(randomStr))
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// provider-defined functions were added in Terraform 1.8
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "sleuth_project" "terraform_acc_test" {
+	name = "%s"
+}
+
+output "slug" {
+	value = provider::sleuth::slugify(sleuth_project.terraform_acc_test.name)
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sleuth_project.terraform_acc_test", "slug", slug),
+					resource.TestCheckOutput("slug", slug),
+				),
+			},
+		},
+	})
+}
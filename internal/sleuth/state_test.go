@@ -0,0 +1,440 @@
+package sleuth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+// loadRecordedResponse decodes an API response recorded in testdata into v
+func loadRecordedResponse(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetNewStateFromEnvSurfacesDrift(t *testing.T) {
+	tests := []struct {
+		response string
+		expected envResourceModel
+	}{
+		{
+			response: "environment_drifted.json",
+			expected: envResourceModel{
+				ID:          types.StringValue("prod"),
+				ProjectSlug: types.StringValue("payments"),
+				Name:        types.StringValue("Production (EU)"),
+				Slug:        types.StringValue("prod"),
+				Description: types.StringValue("Changed in the Sleuth UI"),
+				Color:       types.StringValue("#ff0000"),
+			},
+		},
+		{
+			response: "environment_cleared.json",
+			expected: envResourceModel{
+				ID:          types.StringValue("prod"),
+				ProjectSlug: types.StringValue("payments"),
+				Name:        types.StringValue("Production"),
+				Slug:        types.StringValue("prod"),
+				Description: types.StringValue(""),
+				Color:       types.StringValue("#cecece"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.response, func(t *testing.T) {
+			var env gqlclient.Environment
+			loadRecordedResponse(t, tt.response, &env)
+
+			state := getNewStateFromEnv(&env, "payments")
+			if diff := cmp.Diff(tt.expected, state); diff != "" {
+				t.Errorf("unexpected state (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNewStateFromErrorImpactSourceSurfacesDrift(t *testing.T) {
+	tests := []struct {
+		response string
+		expected errorImpactResourceModel
+	}{
+		{
+			response: "error_impact_source_drifted.json",
+			expected: errorImpactResourceModel{
+				ID:                         types.StringValue("sentry-errors"),
+				Slug:                       types.StringValue("sentry-errors"),
+				ProjectSlug:                types.StringValue("payments"),
+				EnvironmentSlug:            types.StringValue("staging"),
+				Name:                       types.StringValue("Sentry errors"),
+				ProviderType:               newCaseInsensitiveStringValue("sentry"),
+				ErrorOrgKey:                types.StringValue("sleuth"),
+				ErrorProjectKey:            types.StringValue("payments-v2"),
+				ErrorEnvironment:           types.StringValue("staging"),
+				ManuallySetHealthThreshold: types.Float64Value(12.5),
+				IntegrationSlug:            types.StringValue("sentry-main"),
+			},
+		},
+		{
+			// the threshold and integration were removed in the Sleuth UI
+			response: "error_impact_source_cleared.json",
+			expected: errorImpactResourceModel{
+				ID:                         types.StringValue("sentry-errors"),
+				Slug:                       types.StringValue("sentry-errors"),
+				ProjectSlug:                types.StringValue("payments"),
+				EnvironmentSlug:            types.StringValue("prod"),
+				Name:                       types.StringValue("Sentry errors"),
+				ProviderType:               newCaseInsensitiveStringValue("sentry"),
+				ErrorOrgKey:                types.StringValue("sleuth"),
+				ErrorProjectKey:            types.StringValue("payments"),
+				ErrorEnvironment:           types.StringValue(""),
+				ManuallySetHealthThreshold: types.Float64Null(),
+				IntegrationSlug:            types.StringValue(""),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.response, func(t *testing.T) {
+			var eis gqlclient.ErrorImpactSource
+			loadRecordedResponse(t, tt.response, &eis)
+
+			state := getNewStateFromErrorImpactSource(&eis, "payments")
+			if diff := cmp.Diff(tt.expected, state); diff != "" {
+				t.Errorf("unexpected state (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNewStateFromMetricImpactSourceSurfacesDrift(t *testing.T) {
+	tests := []struct {
+		response string
+		expected metricImpactResourceModel
+	}{
+		{
+			response: "metric_impact_source_drifted.json",
+			expected: metricImpactResourceModel{
+				ID:                         types.StringValue("datadog-latency"),
+				Slug:                       types.StringValue("datadog-latency"),
+				ProjectSlug:                types.StringValue("payments"),
+				EnvSlug:                    types.StringValue("prod"),
+				Name:                       types.StringValue("Datadog latency"),
+				ProviderType:               newCaseInsensitiveStringValue("datadog"),
+				IntegrationSlug:            types.StringValue("datadog-main"),
+				Query:                      types.StringValue("avg:trace.http.request.duration{service:payments}"),
+				LessIsBetter:               types.BoolValue(false),
+				ManuallySetHealthThreshold: types.Float64Value(250),
+			},
+		},
+		{
+			// the threshold and integration were removed in the Sleuth UI
+			response: "metric_impact_source_cleared.json",
+			expected: metricImpactResourceModel{
+				ID:                         types.StringValue("datadog-latency"),
+				Slug:                       types.StringValue("datadog-latency"),
+				ProjectSlug:                types.StringValue("payments"),
+				EnvSlug:                    types.StringValue("prod"),
+				Name:                       types.StringValue("Datadog latency"),
+				ProviderType:               newCaseInsensitiveStringValue("datadog"),
+				IntegrationSlug:            types.StringValue(""),
+				Query:                      types.StringValue("avg:trace.http.request.duration{service:payments}"),
+				LessIsBetter:               types.BoolValue(true),
+				ManuallySetHealthThreshold: types.Float64Null(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.response, func(t *testing.T) {
+			var mis gqlclient.MetricImpactSource
+			loadRecordedResponse(t, tt.response, &mis)
+
+			state := getNewStateFromMetricImpactSource(&mis, "payments")
+			if diff := cmp.Diff(tt.expected, state); diff != "" {
+				t.Errorf("unexpected state (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNewStateFromCodeChangeSourceSurfacesDrift(t *testing.T) {
+	var ccs gqlclient.CodeChangeSource
+	loadRecordedResponse(t, "code_change_source_drifted.json", &ccs)
+
+	prior := codeChangeResourceModel{
+		Repository: &repositoryResourceModel{Provider: newCaseInsensitiveStringValue("github")},
+		BuildMappings: []buildMappingsResourceModel{{
+			EnvironmentSlug: types.StringValue("prod"),
+			Provider:        newCaseInsensitiveStringValue("github"),
+			BuildName:       types.StringValue("deploy"),
+			ProjectKey:      types.StringValue("gh/sleuth-io/payments"),
+			ProjectName:     types.StringValue("payments"),
+		}},
+	}
+
+	state, diags := getNewStateFromCodeChangeSource(context.Background(), &ccs, "payments", prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	if state.Name.ValueString() != "Payments API (renamed)" {
+		t.Errorf("expected the renamed source, got %s", state.Name)
+	}
+	if state.Repository.Provider.ValueString() != "GITLAB" {
+		t.Errorf("expected repository provider GITLAB, got %s", state.Repository.Provider)
+	}
+	if state.PathPrefix.ValueString() != "services/payments" || state.AutoTrackingDelay.ValueInt64() != 120 {
+		t.Errorf("expected path prefix and tracking delay from the API, got %+v", state)
+	}
+	if len(state.EnvironmentMappings) != 1 || state.EnvironmentMappings[0].Branch.ValueString() != "release" {
+		t.Errorf("expected the release branch mapping, got %+v", state.EnvironmentMappings)
+	}
+
+	if len(state.BuildMappings) != 1 {
+		t.Fatalf("expected 1 build mapping, got %d", len(state.BuildMappings))
+	}
+	bm := state.BuildMappings[0]
+	if bm.Provider.ValueString() != "CIRCLECI" {
+		t.Errorf("expected build provider CIRCLECI, got %s", bm.Provider)
+	}
+	if bm.ProjectKey.ValueString() != "gh/sleuth-io/payments-v2" {
+		t.Errorf("expected the changed project key, got %s", bm.ProjectKey)
+	}
+	if bm.ProjectName.ValueString() != "payments-v2" {
+		t.Errorf("expected the changed project name, got %s", bm.ProjectName)
+	}
+	if bm.JobName.ValueString() != "deploy-prod" || bm.MatchBranchToEnvironment.ValueBool() {
+		t.Errorf("expected job name and branch matching from the API, got %+v", bm)
+	}
+}
+
+func TestGetNewStateFromCodeChangeSourceKeepsConfiguredValues(t *testing.T) {
+	var ccs gqlclient.CodeChangeSource
+	loadRecordedResponse(t, "code_change_source_drifted.json", &ccs)
+
+	plan := codeChangeResourceModel{
+		Repository: &repositoryResourceModel{Provider: newCaseInsensitiveStringValue("gitlab")},
+		BuildMappings: []buildMappingsResourceModel{{
+			EnvironmentSlug: types.StringValue("prod"),
+			Provider:        newCaseInsensitiveStringValue("circleci"),
+			BuildName:       types.StringValue("deploy"),
+			ProjectKey:      types.StringUnknown(),
+			ProjectName:     types.StringValue("payments-v2"),
+		}},
+	}
+
+	state, diags := getNewStateFromCodeChangeSource(context.Background(), &ccs, "payments", plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	// providers are stored as the API returns them, semantic equality keeps the configured case
+	if state.Repository.Provider.ValueString() != "GITLAB" {
+		t.Errorf("expected the repository provider from the API, got %s", state.Repository.Provider)
+	}
+	bm := state.BuildMappings[0]
+	if bm.Provider.ValueString() != "CIRCLECI" {
+		t.Errorf("expected the build provider from the API, got %s", bm.Provider)
+	}
+	if bm.ProjectName.ValueString() != "payments-v2" {
+		t.Errorf("expected the configured project_name, got %s", bm.ProjectName)
+	}
+	if bm.ProjectKey.ValueString() != "gh/sleuth-io/payments-v2" {
+		t.Errorf("expected the project key the name resolved to, got %s", bm.ProjectKey)
+	}
+}
+
+func TestGetNewStateFromCodeChangeSourceOnImport(t *testing.T) {
+	var ccs gqlclient.CodeChangeSource
+	loadRecordedResponse(t, "code_change_source_drifted.json", &ccs)
+
+	state, diags := getNewStateFromCodeChangeSource(context.Background(), &ccs, "payments", codeChangeResourceModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	if state.Repository.GitLab.IsNull() || !state.Repository.Provider.IsNull() {
+		t.Errorf("expected the repository in the gitlab block, got provider %s and gitlab %s", state.Repository.Provider, state.Repository.GitLab)
+	}
+	if state.BuildMappings[0].ProjectName.ValueString() != "payments-v2" {
+		t.Errorf("expected the project_name from the API on import, got %s", state.BuildMappings[0].ProjectName)
+	}
+}
+
+func TestCodeChangeSourceResourceUpgradeStateFromLists(t *testing.T) {
+	ctx := context.Background()
+	ccsr := &codeChangeSourceResource{}
+	upgrader := ccsr.UpgradeState(ctx)[0]
+
+	priorState := `{
+		"id": "payments-api",
+		"project_slug": "payments",
+		"name": "Payments API",
+		"slug": "payments-api",
+		"environment_mappings": [
+			{"environment_slug": "staging", "branch": "develop", "id": "payments/staging"},
+			{"environment_slug": "production", "branch": "main", "id": "payments/production"}
+		],
+		"build_mappings": [
+			{"environment_slug": "production", "provider": "GITHUB", "integration_slug": "github", "build_name": "release",
+			 "job_name": "deploy", "project_key": "sleuth-io/payments", "project_name": null,
+			 "match_branch_to_environment": false, "is_custom": false}
+		]
+	}`
+	raw, err := tftypes.ValueFromJSON([]byte(priorState), upgrader.PriorSchema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var current resource.SchemaResponse
+	ccsr.Schema(ctx, resource.SchemaRequest{}, &current)
+	req := resource.UpgradeStateRequest{State: &tfsdk.State{Raw: raw, Schema: *upgrader.PriorSchema}}
+	res := resource.UpgradeStateResponse{State: tfsdk.State{Schema: current.Schema}}
+	upgrader.StateUpgrader(ctx, req, &res)
+	if res.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", res.Diagnostics)
+	}
+
+	var state codeChangeSourceResourceModel
+	if diags := res.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expectedEnvironmentMappings := []environmentMappingsResourceModel{
+		{EnvironmentSlug: types.StringValue("staging"), Branch: types.StringValue("develop"), ID: types.StringValue("payments/staging")},
+		{EnvironmentSlug: types.StringValue("production"), Branch: types.StringValue("main"), ID: types.StringValue("payments/production")},
+	}
+	if diff := cmp.Diff(expectedEnvironmentMappings, state.EnvironmentMappings); diff != "" {
+		t.Errorf("unexpected environment mappings (-expected +got):\n%s", diff)
+	}
+	expectedBuildMappings := []buildMappingsResourceModel{
+		{
+			EnvironmentSlug:          types.StringValue("production"),
+			Provider:                 newCaseInsensitiveStringValue("GITHUB"),
+			IntegrationSlug:          types.StringValue("github"),
+			BuildName:                types.StringValue("release"),
+			JobName:                  types.StringValue("deploy"),
+			ProjectKey:               types.StringValue("sleuth-io/payments"),
+			ProjectName:              types.StringNull(),
+			MatchBranchToEnvironment: types.BoolValue(false),
+			IsCustom:                 types.BoolValue(false),
+		},
+	}
+	if diff := cmp.Diff(expectedBuildMappings, state.BuildMappings); diff != "" {
+		t.Errorf("unexpected build mappings (-expected +got):\n%s", diff)
+	}
+	if state.Name.ValueString() != "Payments API" {
+		t.Errorf("expected name to be kept, got %s", state.Name)
+	}
+	if !state.IgnoreExternalMappings.Equal(types.BoolValue(false)) {
+		t.Errorf("expected ignore_external_mappings to be false, got %s", state.IgnoreExternalMappings)
+	}
+	if !state.InitializeChanges.IsNull() || !state.InitialHistoryDays.IsNull() {
+		t.Errorf("expected no initialize_changes and initial_history_days, got %s and %s", state.InitializeChanges, state.InitialHistoryDays)
+	}
+}
+
+func TestIncidentProviderImpactSourceMoveState(t *testing.T) {
+	ctx := context.Background()
+	iisr := NewDataDogIncidentImpactSourceResource().(*incidentProviderImpactSourceResource[dataDogIncidentImpactSourceResourceModel])
+	mover := iisr.MoveState(ctx)[0]
+
+	sourceState := func(t *testing.T, providerName string) *tfsdk.State {
+		sourceJSON := fmt.Sprintf(`{
+			"id": "datadog-incidents",
+			"slug": "datadog-incidents",
+			"project_slug": "payments",
+			"environment_name": "Production",
+			"name": "Datadog incidents",
+			"provider_name": %q,
+			"datadog_input": {"query": "service:payments", "remote_priority_threshold": "P2", "integration_slug": "datadog"}
+		}`, providerName)
+		raw, err := tftypes.ValueFromJSON([]byte(sourceJSON), mover.SourceSchema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &tfsdk.State{Raw: raw, Schema: *mover.SourceSchema}
+	}
+	move := func(req resource.MoveStateRequest) resource.MoveStateResponse {
+		var target resource.SchemaResponse
+		iisr.Schema(ctx, resource.SchemaRequest{}, &target)
+		res := resource.MoveStateResponse{
+			TargetState: tfsdk.State{Schema: target.Schema, Raw: tftypes.NewValue(target.Schema.Type().TerraformType(ctx), nil)},
+		}
+		mover.StateMover(ctx, req, &res)
+		return res
+	}
+
+	t.Run("input block becomes the top level attributes", func(t *testing.T) {
+		res := move(resource.MoveStateRequest{
+			SourceTypeName:        "sleuth_incident_impact_source",
+			SourceProviderAddress: "registry.terraform.io/sleuth-io/sleuth",
+			SourceState:           sourceState(t, "datadog"),
+		})
+		if res.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", res.Diagnostics)
+		}
+
+		var state dataDogIncidentImpactSourceResourceModel
+		if diags := res.TargetState.Get(ctx, &state); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		expectedSource := providerImpactSourceResourceModel{
+			ID:              types.StringValue("datadog-incidents"),
+			Slug:            types.StringValue("datadog-incidents"),
+			ProjectSlug:     types.StringValue("payments"),
+			EnvironmentName: types.StringValue("Production"),
+			Name:            types.StringValue("Datadog incidents"),
+		}
+		if diff := cmp.Diff(expectedSource, state.providerImpactSourceResourceModel); diff != "" {
+			t.Errorf("unexpected impact source (-expected +got):\n%s", diff)
+		}
+		expectedInput := dataDogInputResourceModel{
+			Query:                   types.StringValue("service:payments"),
+			RemotePriorityThreshold: newCaseInsensitiveStringValue("P2"),
+			IntegrationSlug:         types.StringValue("datadog"),
+		}
+		if diff := cmp.Diff(expectedInput, state.dataDogInputResourceModel); diff != "" {
+			t.Errorf("unexpected datadog input (-expected +got):\n%s", diff)
+		}
+	})
+
+	t.Run("sources of other providers are rejected", func(t *testing.T) {
+		res := move(resource.MoveStateRequest{
+			SourceTypeName:        "sleuth_incident_impact_source",
+			SourceProviderAddress: "registry.terraform.io/sleuth-io/sleuth",
+			SourceState:           sourceState(t, "pagerduty"),
+		})
+		if !res.Diagnostics.HasError() {
+			t.Error("expected an error moving a pagerduty source")
+		}
+	})
+
+	t.Run("other resource types are left to other movers", func(t *testing.T) {
+		res := move(resource.MoveStateRequest{
+			SourceTypeName:        "sleuth_metric_impact_source",
+			SourceProviderAddress: "registry.terraform.io/sleuth-io/sleuth",
+		})
+		if res.Diagnostics.HasError() || !res.TargetState.Raw.IsNull() {
+			t.Errorf("expected no move, got %v and %s", res.Diagnostics, res.TargetState.Raw)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package sleuth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sleuth-io/terraform-provider-sleuth/internal/gqlclient"
+)
+
+var (
+	_ datasource.DataSource              = &teamDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamDataSource{}
+)
+
+type teamDataSource struct {
+	c *gqlclient.Client
+}
+
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+func (t *teamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Description: "Team data source looks up an existing Sleuth team by its slug.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Team slug",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Team name",
+				Computed:            true,
+			},
+			"parent_slug": schema.StringAttribute{
+				MarkdownDescription: "Parent team slug (for subteams)",
+				Computed:            true,
+			},
+			"members": schema.ListAttribute{
+				Description: "List of emails of the team members.",
+				ElementType: basetypes.StringType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (t *teamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	t.c = req.ProviderData.(*gqlclient.Client)
+}
+
+func (t *teamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (t *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	ctx = tflog.SetField(ctx, "data_source", "team")
+	ctx = tflog.SetField(ctx, "operation", "read")
+
+	var config teamResourceModel
+	diags := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	slug := config.Slug.ValueString()
+	team, err := t.c.GetTeam(ctx, &slug)
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.Diagnostics.AddError("Team not found", fmt.Sprintf("Could not find Team with slug %s", slug))
+		return
+	}
+	if err != nil {
+		res.Diagnostics.AddError("Error reading team", err.Error())
+		return
+	}
+	emails, err := getTeamMemberEmails(t.c, team.Slug)
+	if err != nil {
+		res.Diagnostics.AddError("Error fetching team members", err.Error())
+		return
+	}
+	state := getNewStateFromTeam(team, emails, false)
+	res.Diagnostics.Append(res.State.Set(ctx, state)...)
+}
@@ -0,0 +1,50 @@
+package sleuth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTeamDataSource_v6(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+	teamName := fmt.Sprintf("Terraform test team %s", randomStr)
+	parentName := fmt.Sprintf("Terraform parent team %s", randomStr)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBetween(tfversion.Version0_14_0, tfversion.Version0_15_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: teamDataSourceConfig(parentName, teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sleuth_team.terraform_acc_test", "name", teamName),
+					resource.TestCheckResourceAttrPair("data.sleuth_team.terraform_acc_test", "parent_slug", "sleuth_team.terraform_acc_parent", "slug"),
+					resource.TestCheckResourceAttrPair("data.sleuth_team.terraform_acc_test", "id", "sleuth_team.terraform_acc_test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func teamDataSourceConfig(parentName, name string) string {
+	return fmt.Sprintf(`
+resource "sleuth_team" "terraform_acc_parent" {
+  name = "%s"
+}
+
+resource "sleuth_team" "terraform_acc_test" {
+  name        = "%s"
+  parent_slug = sleuth_team.terraform_acc_parent.slug
+}
+
+data "sleuth_team" "terraform_acc_test" {
+  slug = sleuth_team.terraform_acc_test.slug
+}
+`, parentName, name)
+}
@@ -2,6 +2,7 @@ package sleuth
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ var (
 	_ resource.Resource                = &teamResource{}
 	_ resource.ResourceWithConfigure   = &teamResource{}
 	_ resource.ResourceWithImportState = &teamResource{}
+	_ resource.ResourceWithModifyPlan  = &teamResource{}
 )
 
 type teamResourceModel struct {
@@ -80,6 +82,20 @@ func (t *teamResource) Metadata(ctx context.Context, req resource.MetadataReques
 	res.TypeName = req.ProviderTypeName + "_team"
 }
 
+// ModifyPlan fails the plan when the parent team does not exist, see validate_references_on_plan
+func (t *teamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	references, ok := newReferenceValidator(t.c, req, res)
+	if !ok {
+		return
+	}
+	var parentSlug types.String
+	res.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parent_slug"), &parentSlug)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	references.team(ctx, path.Root("parent_slug"), parentSlug)
+}
+
 func (t *teamResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
 	var plan teamResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -101,10 +117,7 @@ func (t *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	team, err := t.c.CreateTeam(ctx, input)
 	if err != nil {
-		res.Diagnostics.AddError(
-			"Error creating team",
-			err.Error(),
-		)
+		addMutationErrorDiagnostics(&res.Diagnostics, "Error creating team", err.Error(), err, teamFieldAliases)
 		return
 	}
 
@@ -131,7 +144,7 @@ func (t *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 			}
 			err = t.c.AddTeamMembers(ctx, addInput)
 			if err != nil {
-				res.Diagnostics.AddError("Error adding team members", err.Error())
+				addMutationErrorDiagnostics(&res.Diagnostics, "Error adding team members", err.Error(), err, teamFieldAliases)
 				return
 			}
 		}
@@ -158,12 +171,12 @@ func (t *teamResource) Read(ctx context.Context, req resource.ReadRequest, res *
 
 	slug := state.Slug.ValueString()
 	team, err := t.c.GetTeam(ctx, &slug)
-	if err != nil {
-		res.Diagnostics.AddError("Error reading team", err.Error())
+	if errors.Is(err, gqlclient.ErrNotFound) {
+		res.State.RemoveResource(ctx)
 		return
 	}
-	if team == nil {
-		res.State.RemoveResource(ctx)
+	if err != nil {
+		res.Diagnostics.AddError("Error reading team", err.Error())
 		return
 	}
 	emails, err := getTeamMemberEmails(t.c, team.Slug)
@@ -210,7 +223,7 @@ func (t *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 		updatedTeam, err = t.c.UpdateTeam(ctx, &slug, input)
 		if err != nil {
-			res.Diagnostics.AddError("Error updating team", err.Error())
+			addMutationErrorDiagnostics(&res.Diagnostics, "Error updating team", err.Error(), err, teamFieldAliases)
 			return
 		}
 	}
@@ -266,7 +279,7 @@ func (t *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			}
 			err = t.c.AddTeamMembers(ctx, addInput)
 			if err != nil {
-				res.Diagnostics.AddError("Error adding team members", err.Error())
+				addMutationErrorDiagnostics(&res.Diagnostics, "Error adding team members", err.Error(), err, teamFieldAliases)
 				return
 			}
 		}
@@ -288,7 +301,7 @@ func (t *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			}
 			err = t.c.RemoveTeamMembers(ctx, removeInput)
 			if err != nil {
-				res.Diagnostics.AddError("Error removing team members", err.Error())
+				addMutationErrorDiagnostics(&res.Diagnostics, "Error removing team members", err.Error(), err, teamFieldAliases)
 				return
 			}
 		}
@@ -404,10 +417,11 @@ func getTeamMemberEmails(c *gqlclient.Client, slug string) ([]string, error) {
 			Members struct {
 				Objects []gqlclient.User `graphql:"objects"`
 			} `graphql:"members(page: $page, pageSize: $pageSize)"`
-		} `graphql:"team(teamSlug: $teamSlug)"`
+		} `graphql:"team(teamSlug: $teamSlug, orgSlug: $orgSlug)"`
 	}
 	variables := map[string]interface{}{
 		"teamSlug": graphql.ID(slug),
+		"orgSlug":  graphql.ID(c.OrgSlug),
 		"page":     graphql.Int(1),
 		"pageSize": graphql.Int(50),
 	}
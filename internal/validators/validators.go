@@ -0,0 +1,92 @@
+// Package validators holds the plan-time attribute validators shared by the resource schemas, so invalid values are
+// reported before apply instead of as an API error halfway through it.
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = oneOfValidator{}
+
+type oneOfValidator struct {
+	values []string
+}
+
+// OneOfCaseInsensitive returns a validator checking that a string attribute is one of values, in any case. It is meant
+// for attributes the provider converts to the case the API expects.
+func OneOfCaseInsensitive(values ...string) validator.String {
+	return oneOfValidator{values: values}
+}
+
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s, in any case", quoteAll(v.values))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, allowed := range v.values {
+		if strings.EqualFold(value, allowed) {
+			return
+		}
+	}
+	res.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid attribute value",
+		fmt.Sprintf("%s %s, got: %q", req.Path, v.Description(ctx), value),
+	)
+}
+
+var _ validator.String = hexColorValidator{}
+
+var hexColorRegexp = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+type hexColorValidator struct{}
+
+// HexColor returns a validator checking that a string attribute is a `#rgb` or `#rrggbb` hex color
+func HexColor() validator.String {
+	return hexColorValidator{}
+}
+
+func (v hexColorValidator) Description(_ context.Context) string {
+	return "value must be a hex color such as `#cecece` or `#ccc`"
+}
+
+func (v hexColorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hexColorValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !hexColorRegexp.MatchString(value) {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid attribute value",
+			fmt.Sprintf("%s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return strings.Join(quoted, ", ")
+}
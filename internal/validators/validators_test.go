@@ -0,0 +1,64 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name          string
+		validator     validator.String
+		value         types.String
+		expectedError string
+	}{
+		{name: "one of in any case", validator: OneOfCaseInsensitive("GITHUB", "GITLAB"), value: types.StringValue("GitLab")},
+		{
+			name:          "not one of in any case",
+			validator:     OneOfCaseInsensitive("GITHUB", "GITLAB"),
+			value:         types.StringValue("GITHUBB"),
+			expectedError: `remote_urgency value must be one of: "GITHUB", "GITLAB", in any case, got: "GITHUBB"`,
+		},
+		{name: "one of null", validator: OneOfCaseInsensitive("HIGH"), value: types.StringNull()},
+		{name: "one of unknown", validator: OneOfCaseInsensitive("HIGH"), value: types.StringUnknown()},
+		{name: "hex color", validator: HexColor(), value: types.StringValue("#1a2B3c")},
+		{name: "short hex color", validator: HexColor(), value: types.StringValue("#abc")},
+		{
+			name:          "named color",
+			validator:     HexColor(),
+			value:         types.StringValue("red"),
+			expectedError: "remote_urgency value must be a hex color such as `#cecece` or `#ccc`, got: \"red\"",
+		},
+		{
+			name:          "hex color without hash",
+			validator:     HexColor(),
+			value:         types.StringValue("cecece"),
+			expectedError: "remote_urgency value must be a hex color such as `#cecece` or `#ccc`, got: \"cecece\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("remote_urgency"), ConfigValue: tt.value}
+			var res validator.StringResponse
+			tt.validator.ValidateString(context.Background(), req, &res)
+
+			if tt.expectedError == "" {
+				if res.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", res.Diagnostics)
+				}
+				return
+			}
+			if len(res.Diagnostics) != 1 {
+				t.Fatalf("expected one error, got %v", res.Diagnostics)
+			}
+			if detail := res.Diagnostics[0].Detail(); detail != tt.expectedError {
+				t.Errorf("expected error %q, got %q", tt.expectedError, detail)
+			}
+		})
+	}
+}